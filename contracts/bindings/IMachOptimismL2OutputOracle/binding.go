@@ -0,0 +1,721 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contractIMachOptimismL2OutputOracle
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IMachOptimismL2OutputOracleOutputProposal is an auto generated low-level Go binding around an user-defined struct.
+type IMachOptimismL2OutputOracleOutputProposal struct {
+	OutputRoot    [32]byte
+	Timestamp     *big.Int
+	L2BlockNumber *big.Int
+}
+
+// ContractIMachOptimismL2OutputOracleMetaData contains all meta data concerning the ContractIMachOptimismL2OutputOracle contract.
+var ContractIMachOptimismL2OutputOracleMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"PROPOSER\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SUBMISSION_INTERVAL\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getL2Output\",\"inputs\":[{\"name\":\"_l2OutputIndex\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIMachOptimismL2OutputOracle.OutputProposal\",\"components\":[{\"name\":\"outputRoot\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"timestamp\",\"type\":\"uint128\",\"internalType\":\"uint128\"},{\"name\":\"l2BlockNumber\",\"type\":\"uint128\",\"internalType\":\"uint128\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getL2OutputAfter\",\"inputs\":[{\"name\":\"_l2BlockNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIMachOptimismL2OutputOracle.OutputProposal\",\"components\":[{\"name\":\"outputRoot\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"timestamp\",\"type\":\"uint128\",\"internalType\":\"uint128\"},{\"name\":\"l2BlockNumber\",\"type\":\"uint128\",\"internalType\":\"uint128\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getL2OutputIndexAfter\",\"inputs\":[{\"name\":\"_l2BlockNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"latestBlockNumber\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"latestOutputIndex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"OutputProposed\",\"inputs\":[{\"name\":\"outputRoot\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"l2OutputIndex\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"},{\"name\":\"l2BlockNumber\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"},{\"name\":\"l1Timestamp\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OutputsDeleted\",\"inputs\":[{\"name\":\"prevNextOutputIndex\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"},{\"name\":\"newNextOutputIndex\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false}]",
+}
+
+// ContractIMachOptimismL2OutputOracleABI is the input ABI used to generate the binding from.
+// Deprecated: Use ContractIMachOptimismL2OutputOracleMetaData.ABI instead.
+var ContractIMachOptimismL2OutputOracleABI = ContractIMachOptimismL2OutputOracleMetaData.ABI
+
+// ContractIMachOptimismL2OutputOracle is an auto generated Go binding around an Ethereum contract.
+type ContractIMachOptimismL2OutputOracle struct {
+	ContractIMachOptimismL2OutputOracleCaller     // Read-only binding to the contract
+	ContractIMachOptimismL2OutputOracleTransactor // Write-only binding to the contract
+	ContractIMachOptimismL2OutputOracleFilterer   // Log filterer for contract events
+}
+
+// ContractIMachOptimismL2OutputOracleCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ContractIMachOptimismL2OutputOracleCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ContractIMachOptimismL2OutputOracleTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ContractIMachOptimismL2OutputOracleTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ContractIMachOptimismL2OutputOracleFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ContractIMachOptimismL2OutputOracleFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ContractIMachOptimismL2OutputOracleSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ContractIMachOptimismL2OutputOracleSession struct {
+	Contract     *ContractIMachOptimismL2OutputOracle // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                        // Call options to use throughout this session
+	TransactOpts bind.TransactOpts                    // Transaction auth options to use throughout this session
+}
+
+// ContractIMachOptimismL2OutputOracleCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ContractIMachOptimismL2OutputOracleCallerSession struct {
+	Contract *ContractIMachOptimismL2OutputOracleCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                              // Call options to use throughout this session
+}
+
+// ContractIMachOptimismL2OutputOracleTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ContractIMachOptimismL2OutputOracleTransactorSession struct {
+	Contract     *ContractIMachOptimismL2OutputOracleTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                              // Transaction auth options to use throughout this session
+}
+
+// ContractIMachOptimismL2OutputOracleRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ContractIMachOptimismL2OutputOracleRaw struct {
+	Contract *ContractIMachOptimismL2OutputOracle // Generic contract binding to access the raw methods on
+}
+
+// ContractIMachOptimismL2OutputOracleCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ContractIMachOptimismL2OutputOracleCallerRaw struct {
+	Contract *ContractIMachOptimismL2OutputOracleCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ContractIMachOptimismL2OutputOracleTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ContractIMachOptimismL2OutputOracleTransactorRaw struct {
+	Contract *ContractIMachOptimismL2OutputOracleTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewContractIMachOptimismL2OutputOracle creates a new instance of ContractIMachOptimismL2OutputOracle, bound to a specific deployed contract.
+func NewContractIMachOptimismL2OutputOracle(address common.Address, backend bind.ContractBackend) (*ContractIMachOptimismL2OutputOracle, error) {
+	contract, err := bindContractIMachOptimismL2OutputOracle(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractIMachOptimismL2OutputOracle{ContractIMachOptimismL2OutputOracleCaller: ContractIMachOptimismL2OutputOracleCaller{contract: contract}, ContractIMachOptimismL2OutputOracleTransactor: ContractIMachOptimismL2OutputOracleTransactor{contract: contract}, ContractIMachOptimismL2OutputOracleFilterer: ContractIMachOptimismL2OutputOracleFilterer{contract: contract}}, nil
+}
+
+// NewContractIMachOptimismL2OutputOracleCaller creates a new read-only instance of ContractIMachOptimismL2OutputOracle, bound to a specific deployed contract.
+func NewContractIMachOptimismL2OutputOracleCaller(address common.Address, caller bind.ContractCaller) (*ContractIMachOptimismL2OutputOracleCaller, error) {
+	contract, err := bindContractIMachOptimismL2OutputOracle(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractIMachOptimismL2OutputOracleCaller{contract: contract}, nil
+}
+
+// NewContractIMachOptimismL2OutputOracleTransactor creates a new write-only instance of ContractIMachOptimismL2OutputOracle, bound to a specific deployed contract.
+func NewContractIMachOptimismL2OutputOracleTransactor(address common.Address, transactor bind.ContractTransactor) (*ContractIMachOptimismL2OutputOracleTransactor, error) {
+	contract, err := bindContractIMachOptimismL2OutputOracle(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractIMachOptimismL2OutputOracleTransactor{contract: contract}, nil
+}
+
+// NewContractIMachOptimismL2OutputOracleFilterer creates a new log filterer instance of ContractIMachOptimismL2OutputOracle, bound to a specific deployed contract.
+func NewContractIMachOptimismL2OutputOracleFilterer(address common.Address, filterer bind.ContractFilterer) (*ContractIMachOptimismL2OutputOracleFilterer, error) {
+	contract, err := bindContractIMachOptimismL2OutputOracle(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractIMachOptimismL2OutputOracleFilterer{contract: contract}, nil
+}
+
+// bindContractIMachOptimismL2OutputOracle binds a generic wrapper to an already deployed contract.
+func bindContractIMachOptimismL2OutputOracle(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ContractIMachOptimismL2OutputOracleMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ContractIMachOptimismL2OutputOracle.Contract.ContractIMachOptimismL2OutputOracleCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ContractIMachOptimismL2OutputOracle.Contract.ContractIMachOptimismL2OutputOracleTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ContractIMachOptimismL2OutputOracle.Contract.ContractIMachOptimismL2OutputOracleTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ContractIMachOptimismL2OutputOracle.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ContractIMachOptimismL2OutputOracle.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ContractIMachOptimismL2OutputOracle.Contract.contract.Transact(opts, method, params...)
+}
+
+// PROPOSER is a free data retrieval call binding the contract method 0xbffa7f0f.
+//
+// Solidity: function PROPOSER() view returns(address)
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleCaller) PROPOSER(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ContractIMachOptimismL2OutputOracle.contract.Call(opts, &out, "PROPOSER")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// PROPOSER is a free data retrieval call binding the contract method 0xbffa7f0f.
+//
+// Solidity: function PROPOSER() view returns(address)
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleSession) PROPOSER() (common.Address, error) {
+	return _ContractIMachOptimismL2OutputOracle.Contract.PROPOSER(&_ContractIMachOptimismL2OutputOracle.CallOpts)
+}
+
+// PROPOSER is a free data retrieval call binding the contract method 0xbffa7f0f.
+//
+// Solidity: function PROPOSER() view returns(address)
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleCallerSession) PROPOSER() (common.Address, error) {
+	return _ContractIMachOptimismL2OutputOracle.Contract.PROPOSER(&_ContractIMachOptimismL2OutputOracle.CallOpts)
+}
+
+// SUBMISSIONINTERVAL is a free data retrieval call binding the contract method 0x529933df.
+//
+// Solidity: function SUBMISSION_INTERVAL() view returns(uint256)
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleCaller) SUBMISSIONINTERVAL(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ContractIMachOptimismL2OutputOracle.contract.Call(opts, &out, "SUBMISSION_INTERVAL")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// SUBMISSIONINTERVAL is a free data retrieval call binding the contract method 0x529933df.
+//
+// Solidity: function SUBMISSION_INTERVAL() view returns(uint256)
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleSession) SUBMISSIONINTERVAL() (*big.Int, error) {
+	return _ContractIMachOptimismL2OutputOracle.Contract.SUBMISSIONINTERVAL(&_ContractIMachOptimismL2OutputOracle.CallOpts)
+}
+
+// SUBMISSIONINTERVAL is a free data retrieval call binding the contract method 0x529933df.
+//
+// Solidity: function SUBMISSION_INTERVAL() view returns(uint256)
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleCallerSession) SUBMISSIONINTERVAL() (*big.Int, error) {
+	return _ContractIMachOptimismL2OutputOracle.Contract.SUBMISSIONINTERVAL(&_ContractIMachOptimismL2OutputOracle.CallOpts)
+}
+
+// GetL2Output is a free data retrieval call binding the contract method 0xa25ae557.
+//
+// Solidity: function getL2Output(uint256 _l2OutputIndex) view returns((bytes32,uint128,uint128))
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleCaller) GetL2Output(opts *bind.CallOpts, _l2OutputIndex *big.Int) (IMachOptimismL2OutputOracleOutputProposal, error) {
+	var out []interface{}
+	err := _ContractIMachOptimismL2OutputOracle.contract.Call(opts, &out, "getL2Output", _l2OutputIndex)
+
+	if err != nil {
+		return *new(IMachOptimismL2OutputOracleOutputProposal), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IMachOptimismL2OutputOracleOutputProposal)).(*IMachOptimismL2OutputOracleOutputProposal)
+
+	return out0, err
+
+}
+
+// GetL2Output is a free data retrieval call binding the contract method 0xa25ae557.
+//
+// Solidity: function getL2Output(uint256 _l2OutputIndex) view returns((bytes32,uint128,uint128))
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleSession) GetL2Output(_l2OutputIndex *big.Int) (IMachOptimismL2OutputOracleOutputProposal, error) {
+	return _ContractIMachOptimismL2OutputOracle.Contract.GetL2Output(&_ContractIMachOptimismL2OutputOracle.CallOpts, _l2OutputIndex)
+}
+
+// GetL2Output is a free data retrieval call binding the contract method 0xa25ae557.
+//
+// Solidity: function getL2Output(uint256 _l2OutputIndex) view returns((bytes32,uint128,uint128))
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleCallerSession) GetL2Output(_l2OutputIndex *big.Int) (IMachOptimismL2OutputOracleOutputProposal, error) {
+	return _ContractIMachOptimismL2OutputOracle.Contract.GetL2Output(&_ContractIMachOptimismL2OutputOracle.CallOpts, _l2OutputIndex)
+}
+
+// GetL2OutputAfter is a free data retrieval call binding the contract method 0xcf8e5cf0.
+//
+// Solidity: function getL2OutputAfter(uint256 _l2BlockNumber) view returns((bytes32,uint128,uint128))
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleCaller) GetL2OutputAfter(opts *bind.CallOpts, _l2BlockNumber *big.Int) (IMachOptimismL2OutputOracleOutputProposal, error) {
+	var out []interface{}
+	err := _ContractIMachOptimismL2OutputOracle.contract.Call(opts, &out, "getL2OutputAfter", _l2BlockNumber)
+
+	if err != nil {
+		return *new(IMachOptimismL2OutputOracleOutputProposal), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IMachOptimismL2OutputOracleOutputProposal)).(*IMachOptimismL2OutputOracleOutputProposal)
+
+	return out0, err
+
+}
+
+// GetL2OutputAfter is a free data retrieval call binding the contract method 0xcf8e5cf0.
+//
+// Solidity: function getL2OutputAfter(uint256 _l2BlockNumber) view returns((bytes32,uint128,uint128))
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleSession) GetL2OutputAfter(_l2BlockNumber *big.Int) (IMachOptimismL2OutputOracleOutputProposal, error) {
+	return _ContractIMachOptimismL2OutputOracle.Contract.GetL2OutputAfter(&_ContractIMachOptimismL2OutputOracle.CallOpts, _l2BlockNumber)
+}
+
+// GetL2OutputAfter is a free data retrieval call binding the contract method 0xcf8e5cf0.
+//
+// Solidity: function getL2OutputAfter(uint256 _l2BlockNumber) view returns((bytes32,uint128,uint128))
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleCallerSession) GetL2OutputAfter(_l2BlockNumber *big.Int) (IMachOptimismL2OutputOracleOutputProposal, error) {
+	return _ContractIMachOptimismL2OutputOracle.Contract.GetL2OutputAfter(&_ContractIMachOptimismL2OutputOracle.CallOpts, _l2BlockNumber)
+}
+
+// GetL2OutputIndexAfter is a free data retrieval call binding the contract method 0x7f006420.
+//
+// Solidity: function getL2OutputIndexAfter(uint256 _l2BlockNumber) view returns(uint256)
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleCaller) GetL2OutputIndexAfter(opts *bind.CallOpts, _l2BlockNumber *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _ContractIMachOptimismL2OutputOracle.contract.Call(opts, &out, "getL2OutputIndexAfter", _l2BlockNumber)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetL2OutputIndexAfter is a free data retrieval call binding the contract method 0x7f006420.
+//
+// Solidity: function getL2OutputIndexAfter(uint256 _l2BlockNumber) view returns(uint256)
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleSession) GetL2OutputIndexAfter(_l2BlockNumber *big.Int) (*big.Int, error) {
+	return _ContractIMachOptimismL2OutputOracle.Contract.GetL2OutputIndexAfter(&_ContractIMachOptimismL2OutputOracle.CallOpts, _l2BlockNumber)
+}
+
+// GetL2OutputIndexAfter is a free data retrieval call binding the contract method 0x7f006420.
+//
+// Solidity: function getL2OutputIndexAfter(uint256 _l2BlockNumber) view returns(uint256)
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleCallerSession) GetL2OutputIndexAfter(_l2BlockNumber *big.Int) (*big.Int, error) {
+	return _ContractIMachOptimismL2OutputOracle.Contract.GetL2OutputIndexAfter(&_ContractIMachOptimismL2OutputOracle.CallOpts, _l2BlockNumber)
+}
+
+// LatestBlockNumber is a free data retrieval call binding the contract method 0x4599c788.
+//
+// Solidity: function latestBlockNumber() view returns(uint256)
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleCaller) LatestBlockNumber(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ContractIMachOptimismL2OutputOracle.contract.Call(opts, &out, "latestBlockNumber")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LatestBlockNumber is a free data retrieval call binding the contract method 0x4599c788.
+//
+// Solidity: function latestBlockNumber() view returns(uint256)
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleSession) LatestBlockNumber() (*big.Int, error) {
+	return _ContractIMachOptimismL2OutputOracle.Contract.LatestBlockNumber(&_ContractIMachOptimismL2OutputOracle.CallOpts)
+}
+
+// LatestBlockNumber is a free data retrieval call binding the contract method 0x4599c788.
+//
+// Solidity: function latestBlockNumber() view returns(uint256)
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleCallerSession) LatestBlockNumber() (*big.Int, error) {
+	return _ContractIMachOptimismL2OutputOracle.Contract.LatestBlockNumber(&_ContractIMachOptimismL2OutputOracle.CallOpts)
+}
+
+// LatestOutputIndex is a free data retrieval call binding the contract method 0x69f16eec.
+//
+// Solidity: function latestOutputIndex() view returns(uint256)
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleCaller) LatestOutputIndex(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ContractIMachOptimismL2OutputOracle.contract.Call(opts, &out, "latestOutputIndex")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LatestOutputIndex is a free data retrieval call binding the contract method 0x69f16eec.
+//
+// Solidity: function latestOutputIndex() view returns(uint256)
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleSession) LatestOutputIndex() (*big.Int, error) {
+	return _ContractIMachOptimismL2OutputOracle.Contract.LatestOutputIndex(&_ContractIMachOptimismL2OutputOracle.CallOpts)
+}
+
+// LatestOutputIndex is a free data retrieval call binding the contract method 0x69f16eec.
+//
+// Solidity: function latestOutputIndex() view returns(uint256)
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleCallerSession) LatestOutputIndex() (*big.Int, error) {
+	return _ContractIMachOptimismL2OutputOracle.Contract.LatestOutputIndex(&_ContractIMachOptimismL2OutputOracle.CallOpts)
+}
+
+// ContractIMachOptimismL2OutputOracleOutputProposedIterator is returned from FilterOutputProposed and is used to iterate over the raw logs and unpacked data for OutputProposed events raised by the ContractIMachOptimismL2OutputOracle contract.
+type ContractIMachOptimismL2OutputOracleOutputProposedIterator struct {
+	Event *ContractIMachOptimismL2OutputOracleOutputProposed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ContractIMachOptimismL2OutputOracleOutputProposedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractIMachOptimismL2OutputOracleOutputProposed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractIMachOptimismL2OutputOracleOutputProposed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ContractIMachOptimismL2OutputOracleOutputProposedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ContractIMachOptimismL2OutputOracleOutputProposedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ContractIMachOptimismL2OutputOracleOutputProposed represents a OutputProposed event raised by the ContractIMachOptimismL2OutputOracle contract.
+type ContractIMachOptimismL2OutputOracleOutputProposed struct {
+	OutputRoot    [32]byte
+	L2OutputIndex *big.Int
+	L2BlockNumber *big.Int
+	L1Timestamp   *big.Int
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOutputProposed is a free log retrieval operation binding the contract event 0xa7aaf2512769da4e444e3de247be2564225c2e7a8f74cfe528e46e17d24868e2.
+//
+// Solidity: event OutputProposed(bytes32 indexed outputRoot, uint256 indexed l2OutputIndex, uint256 indexed l2BlockNumber, uint256 l1Timestamp)
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleFilterer) FilterOutputProposed(opts *bind.FilterOpts, outputRoot [][32]byte, l2OutputIndex []*big.Int, l2BlockNumber []*big.Int) (*ContractIMachOptimismL2OutputOracleOutputProposedIterator, error) {
+
+	var outputRootRule []interface{}
+	for _, outputRootItem := range outputRoot {
+		outputRootRule = append(outputRootRule, outputRootItem)
+	}
+	var l2OutputIndexRule []interface{}
+	for _, l2OutputIndexItem := range l2OutputIndex {
+		l2OutputIndexRule = append(l2OutputIndexRule, l2OutputIndexItem)
+	}
+	var l2BlockNumberRule []interface{}
+	for _, l2BlockNumberItem := range l2BlockNumber {
+		l2BlockNumberRule = append(l2BlockNumberRule, l2BlockNumberItem)
+	}
+
+	logs, sub, err := _ContractIMachOptimismL2OutputOracle.contract.FilterLogs(opts, "OutputProposed", outputRootRule, l2OutputIndexRule, l2BlockNumberRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractIMachOptimismL2OutputOracleOutputProposedIterator{contract: _ContractIMachOptimismL2OutputOracle.contract, event: "OutputProposed", logs: logs, sub: sub}, nil
+}
+
+// WatchOutputProposed is a free log subscription operation binding the contract event 0xa7aaf2512769da4e444e3de247be2564225c2e7a8f74cfe528e46e17d24868e2.
+//
+// Solidity: event OutputProposed(bytes32 indexed outputRoot, uint256 indexed l2OutputIndex, uint256 indexed l2BlockNumber, uint256 l1Timestamp)
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleFilterer) WatchOutputProposed(opts *bind.WatchOpts, sink chan<- *ContractIMachOptimismL2OutputOracleOutputProposed, outputRoot [][32]byte, l2OutputIndex []*big.Int, l2BlockNumber []*big.Int) (event.Subscription, error) {
+
+	var outputRootRule []interface{}
+	for _, outputRootItem := range outputRoot {
+		outputRootRule = append(outputRootRule, outputRootItem)
+	}
+	var l2OutputIndexRule []interface{}
+	for _, l2OutputIndexItem := range l2OutputIndex {
+		l2OutputIndexRule = append(l2OutputIndexRule, l2OutputIndexItem)
+	}
+	var l2BlockNumberRule []interface{}
+	for _, l2BlockNumberItem := range l2BlockNumber {
+		l2BlockNumberRule = append(l2BlockNumberRule, l2BlockNumberItem)
+	}
+
+	logs, sub, err := _ContractIMachOptimismL2OutputOracle.contract.WatchLogs(opts, "OutputProposed", outputRootRule, l2OutputIndexRule, l2BlockNumberRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ContractIMachOptimismL2OutputOracleOutputProposed)
+				if err := _ContractIMachOptimismL2OutputOracle.contract.UnpackLog(event, "OutputProposed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOutputProposed is a log parse operation binding the contract event 0xa7aaf2512769da4e444e3de247be2564225c2e7a8f74cfe528e46e17d24868e2.
+//
+// Solidity: event OutputProposed(bytes32 indexed outputRoot, uint256 indexed l2OutputIndex, uint256 indexed l2BlockNumber, uint256 l1Timestamp)
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleFilterer) ParseOutputProposed(log types.Log) (*ContractIMachOptimismL2OutputOracleOutputProposed, error) {
+	event := new(ContractIMachOptimismL2OutputOracleOutputProposed)
+	if err := _ContractIMachOptimismL2OutputOracle.contract.UnpackLog(event, "OutputProposed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ContractIMachOptimismL2OutputOracleOutputsDeletedIterator is returned from FilterOutputsDeleted and is used to iterate over the raw logs and unpacked data for OutputsDeleted events raised by the ContractIMachOptimismL2OutputOracle contract.
+type ContractIMachOptimismL2OutputOracleOutputsDeletedIterator struct {
+	Event *ContractIMachOptimismL2OutputOracleOutputsDeleted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ContractIMachOptimismL2OutputOracleOutputsDeletedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractIMachOptimismL2OutputOracleOutputsDeleted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractIMachOptimismL2OutputOracleOutputsDeleted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ContractIMachOptimismL2OutputOracleOutputsDeletedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ContractIMachOptimismL2OutputOracleOutputsDeletedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ContractIMachOptimismL2OutputOracleOutputsDeleted represents a OutputsDeleted event raised by the ContractIMachOptimismL2OutputOracle contract.
+type ContractIMachOptimismL2OutputOracleOutputsDeleted struct {
+	PrevNextOutputIndex *big.Int
+	NewNextOutputIndex  *big.Int
+	Raw                 types.Log // Blockchain specific contextual infos
+}
+
+// FilterOutputsDeleted is a free log retrieval operation binding the contract event 0x4ee37ac2c786ec85e87592d3c5c8a1dd66f8496dda3f125d9ea8ca5f657629b6.
+//
+// Solidity: event OutputsDeleted(uint256 indexed prevNextOutputIndex, uint256 indexed newNextOutputIndex)
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleFilterer) FilterOutputsDeleted(opts *bind.FilterOpts, prevNextOutputIndex []*big.Int, newNextOutputIndex []*big.Int) (*ContractIMachOptimismL2OutputOracleOutputsDeletedIterator, error) {
+
+	var prevNextOutputIndexRule []interface{}
+	for _, prevNextOutputIndexItem := range prevNextOutputIndex {
+		prevNextOutputIndexRule = append(prevNextOutputIndexRule, prevNextOutputIndexItem)
+	}
+	var newNextOutputIndexRule []interface{}
+	for _, newNextOutputIndexItem := range newNextOutputIndex {
+		newNextOutputIndexRule = append(newNextOutputIndexRule, newNextOutputIndexItem)
+	}
+
+	logs, sub, err := _ContractIMachOptimismL2OutputOracle.contract.FilterLogs(opts, "OutputsDeleted", prevNextOutputIndexRule, newNextOutputIndexRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractIMachOptimismL2OutputOracleOutputsDeletedIterator{contract: _ContractIMachOptimismL2OutputOracle.contract, event: "OutputsDeleted", logs: logs, sub: sub}, nil
+}
+
+// WatchOutputsDeleted is a free log subscription operation binding the contract event 0x4ee37ac2c786ec85e87592d3c5c8a1dd66f8496dda3f125d9ea8ca5f657629b6.
+//
+// Solidity: event OutputsDeleted(uint256 indexed prevNextOutputIndex, uint256 indexed newNextOutputIndex)
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleFilterer) WatchOutputsDeleted(opts *bind.WatchOpts, sink chan<- *ContractIMachOptimismL2OutputOracleOutputsDeleted, prevNextOutputIndex []*big.Int, newNextOutputIndex []*big.Int) (event.Subscription, error) {
+
+	var prevNextOutputIndexRule []interface{}
+	for _, prevNextOutputIndexItem := range prevNextOutputIndex {
+		prevNextOutputIndexRule = append(prevNextOutputIndexRule, prevNextOutputIndexItem)
+	}
+	var newNextOutputIndexRule []interface{}
+	for _, newNextOutputIndexItem := range newNextOutputIndex {
+		newNextOutputIndexRule = append(newNextOutputIndexRule, newNextOutputIndexItem)
+	}
+
+	logs, sub, err := _ContractIMachOptimismL2OutputOracle.contract.WatchLogs(opts, "OutputsDeleted", prevNextOutputIndexRule, newNextOutputIndexRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ContractIMachOptimismL2OutputOracleOutputsDeleted)
+				if err := _ContractIMachOptimismL2OutputOracle.contract.UnpackLog(event, "OutputsDeleted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOutputsDeleted is a log parse operation binding the contract event 0x4ee37ac2c786ec85e87592d3c5c8a1dd66f8496dda3f125d9ea8ca5f657629b6.
+//
+// Solidity: event OutputsDeleted(uint256 indexed prevNextOutputIndex, uint256 indexed newNextOutputIndex)
+func (_ContractIMachOptimismL2OutputOracle *ContractIMachOptimismL2OutputOracleFilterer) ParseOutputsDeleted(log types.Log) (*ContractIMachOptimismL2OutputOracleOutputsDeleted, error) {
+	event := new(ContractIMachOptimismL2OutputOracleOutputsDeleted)
+	if err := _ContractIMachOptimismL2OutputOracle.contract.UnpackLog(event, "OutputsDeleted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,62 @@
+#!/bin/bash
+# Checks that the go bindings are generated from the current contracts,
+# it fails if the ABI of any binding drifts from the compiled ABI.
+#
+# usage: bash check-go-bindings.sh [contract...], checks all the contracts in go-bindings.sh by default.
+
+set -eo pipefail
+
+source "$(dirname "${BASH_SOURCE[0]}")/go-bindings.sh"
+require_tools forge jq
+
+# the whitespaces are stripped by abigen, and the order of the entries does not matter
+function normalize_abi {
+    jq -cS 'walk(if type == "string" then gsub("\\s"; "") else . end) | sort_by(.type, .name // "", (.inputs | tostring))'
+}
+
+function check_binding {
+    contract_dir=$1
+    contract=$2
+    binding_dir=$3
+
+    contract_json="$contract_dir/out/${contract}.sol/${contract}.json"
+    binding_file="$binding_dir/${contract}/binding.go"
+    if [ ! -f "$contract_json" ]; then
+        echo "the contract $contract is not compiled, $contract_json not found"
+        return 1
+    fi
+    if [ ! -f "$binding_file" ]; then
+        echo "the binding for $contract is missing, run \`make bindings\` to generate it"
+        return 1
+    fi
+
+    if ! solc_abi=$(jq -r '.abi' "$contract_json" | normalize_abi); then
+        echo "read the compiled ABI of $contract from $contract_json failed"
+        return 1
+    fi
+    if ! binding_abi=$(grep -o 'ABI: ".*",' "$binding_file" | sed -e 's/^ABI: "//' -e 's/",$//' -e 's/\\"/"/g' | normalize_abi); then
+        echo "read the ABI of the binding for $contract from $binding_file failed"
+        return 1
+    fi
+
+    if [ "$solc_abi" != "$binding_abi" ]; then
+        echo "the binding for $contract drifts from the compiled ABI, run \`make bindings\` to regenerate it"
+        diff <(echo "$solc_abi" | jq -c '.[]') <(echo "$binding_abi" | jq -c '.[]') || true
+        return 1
+    fi
+
+    echo "the binding for $contract is up to date"
+}
+
+forge build
+
+if [ $# -gt 0 ]; then
+    avs_service_contracts="$*"
+fi
+
+failed=0
+for contract in $avs_service_contracts; do
+    check_binding . $contract ./bindings || failed=1
+done
+
+exit $failed
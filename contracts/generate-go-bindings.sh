@@ -1,5 +1,10 @@
 #!/bin/bash
 
+set -eo pipefail
+
+source "$(dirname "${BASH_SOURCE[0]}")/go-bindings.sh"
+require_tools forge jq abigen
+
 function create_binding {
     contract_dir=$1
     contract=$2
@@ -23,7 +28,6 @@ rm -rf bindings/*
 forge clean
 forge build
 
-avs_service_contracts="MachServiceManager ERC20PresetFixedSupply MachOptimismZkServiceManager"
 for contract in $avs_service_contracts; do
     create_binding . $contract ./bindings
 done
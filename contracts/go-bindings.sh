@@ -0,0 +1,26 @@
+#!/bin/bash
+# The shared settings of generate-go-bindings.sh and check-go-bindings.sh, sourced by them.
+
+# the contracts to generate the go bindings into ./bindings
+avs_service_contracts="MachServiceManager ERC20PresetFixedSupply MachOptimismZkServiceManager IMachOptimismL2OutputOracle"
+
+# fails with the way to install if any of the tools not found
+function require_tools {
+    missing=0
+    for tool in "$@"; do
+        if command -v "$tool" >/dev/null 2>&1; then
+            continue
+        fi
+
+        case $tool in
+        forge) hint="install foundry by https://book.getfoundry.sh/getting-started/installation" ;;
+        abigen) hint="install it by \`go install github.com/ethereum/go-ethereum/cmd/abigen@v1.14.3\`" ;;
+        jq) hint="install it by the package manager, such as \`apt-get install jq\`" ;;
+        *) hint="" ;;
+        esac
+        echo "$tool is required but not found, $hint" >&2
+        missing=1
+    done
+
+    return $missing
+}
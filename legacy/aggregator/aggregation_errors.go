@@ -0,0 +1,44 @@
+package aggregator
+
+import (
+	"fmt"
+
+	blsagg "github.com/Layr-Labs/eigensdk-go/services/bls_aggregation"
+	"github.com/Layr-Labs/eigensdk-go/types"
+
+	"github.com/alt-research/avs/legacy/aggregator/store"
+)
+
+// taskAggregationError is the error of a task from the blsAggregationService, which not return
+// the task index with the error response, so we replace its error builders to keep the task index.
+type taskAggregationError struct {
+	taskIndex types.TaskIndex
+	state     store.TaskState
+	err       error
+}
+
+func (e *taskAggregationError) Error() string {
+	return e.err.Error()
+}
+
+func (e *taskAggregationError) Unwrap() error {
+	return e.err
+}
+
+func init() {
+	blsagg.TaskExpiredErrorFn = func(taskIndex types.TaskIndex) error {
+		return &taskAggregationError{
+			taskIndex: taskIndex,
+			state:     store.TaskStateExpired,
+			err:       fmt.Errorf("task %d expired", taskIndex),
+		}
+	}
+
+	blsagg.TaskInitializationErrorFn = func(err error, taskIndex types.TaskIndex) error {
+		return &taskAggregationError{
+			taskIndex: taskIndex,
+			state:     store.TaskStateFailed,
+			err:       fmt.Errorf("Failed to initialize task %d: %w", taskIndex, err),
+		}
+	}
+}
@@ -2,19 +2,21 @@ package aggregator
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/big"
 	"sync"
 	"time"
 
 	"github.com/Layr-Labs/eigensdk-go/logging"
 	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	blsagg "github.com/Layr-Labs/eigensdk-go/services/bls_aggregation"
-	sdktypes "github.com/Layr-Labs/eigensdk-go/types"
 
 	"github.com/alt-research/avs/legacy/aggregator/rpc"
+	"github.com/alt-research/avs/legacy/aggregator/store"
 	"github.com/alt-research/avs/legacy/core"
-	"github.com/alt-research/avs/legacy/core/chainio"
 	"github.com/alt-research/avs/legacy/core/config"
 	"github.com/alt-research/avs/legacy/core/message"
 
@@ -22,27 +24,12 @@ import (
 )
 
 const (
-	// number of blocks after which a task is considered expired
-	// this hardcoded here because it's also hardcoded in the contracts, but should
-	// ideally be fetched from the contracts
-	taskChallengeWindowBlock = 100
-	blockTimeDuration        = 12 * time.Second
-	avsName                  = "mach"
+	// the max block time of the layer1, only used to give an upper bound for the task expiry
+	// to the blsAggregationService, the task is expired by block height.
+	maxBlockTimeDuration = 60 * time.Second
+	avsName              = "mach"
 )
 
-type FinishedTaskStatus struct {
-	Message          *message.AlertTaskInfo
-	TxHash           common.Hash
-	BlockHash        common.Hash `json:"blockHash,omitempty"`
-	BlockNumber      *big.Int    `json:"blockNumber,omitempty"`
-	TransactionIndex uint        `json:"transactionIndex"`
-}
-
-type OperatorStatus struct {
-	LastTime   int64               `json:"lastTime"`
-	OperatorId sdktypes.OperatorId `json:"operatorId"`
-}
-
 // Aggregator sends tasks (numbers to square) onchain, then listens for operator signed TaskResponses.
 // It aggregates responses signatures, and if any of the TaskResponses reaches the QuorumThresholdPercentage for each quorum
 // (currently we only use a single quorum of the ERC20Mock token), it sends the aggregated TaskResponse and signature onchain.
@@ -83,9 +70,8 @@ type Aggregator struct {
 	grpcServerIpPortAddr    string
 	jsonRpcServerIpPortAddr string
 
-	avsWriter chainio.AvsWriterer
-
 	service       *AggregatorService
+	submitter     *ConfirmAlertSubmitter
 	legacyRpc     *rpc.LegacyRpcHandler
 	gRpc          *rpc.GRpcHandler
 	jsonrpcServer *rpc.JsonRpcServer
@@ -93,12 +79,6 @@ type Aggregator struct {
 
 // NewAggregator creates a new Aggregator with the provided config.
 func NewAggregator(c *config.Config) (*Aggregator, error) {
-	avsWriter, err := chainio.BuildAvsWriterFromConfig(c)
-	if err != nil {
-		c.Logger.Errorf("Cannot create avsWriter", "err", err)
-		return nil, err
-	}
-
 	service, err := NewAggregatorService(c)
 	if err != nil {
 		c.Logger.Errorf("Cannot create NewAggregatorService", "err", err)
@@ -110,7 +90,11 @@ func NewAggregator(c *config.Config) (*Aggregator, error) {
 	var grpcServer *rpc.GRpcHandler
 	if c.AggregatorGRPCServerIpPortAddr != "" {
 		c.Logger.Infof("Create grpc server in %s", c.AggregatorGRPCServerIpPortAddr)
-		grpcServer = rpc.NewGRpcHandler(c.Logger, service)
+		grpcServer, err = rpc.NewGRpcHandler(c.Logger, service, c.GRPCServer, service.metrics, service.authenticator)
+		if err != nil {
+			c.Logger.Error("Cannot create the grpc server", "err", err)
+			return nil, err
+		}
 	}
 
 	var jsonrpcServer *rpc.JsonRpcServer
@@ -119,19 +103,23 @@ func NewAggregator(c *config.Config) (*Aggregator, error) {
 		jsonrpcServer = rpc.NewJsonRpcServer(c.Logger, service, c.RpcVhosts, c.RpcCors)
 	}
 
-	return &Aggregator{
+	agg := &Aggregator{
 		logger:                  c.Logger,
 		serverIpPortAddr:        c.AggregatorServerIpPortAddr,
 		grpcServerIpPortAddr:    c.AggregatorGRPCServerIpPortAddr,
 		jsonRpcServerIpPortAddr: c.AggregatorJSONRPCServerIpPortAddr,
-		avsWriter:               avsWriter,
 		service:                 service,
 		legacyRpc:               legacyRpc,
 		gRpc:                    grpcServer,
 		jsonrpcServer:           jsonrpcServer,
-	}, nil
+	}
+	agg.submitter = NewConfirmAlertSubmitter(c, service.rollups, agg)
+
+	return agg, nil
 }
 
+var _ ConfirmAlertHandler = (*Aggregator)(nil)
+
 func (agg *Aggregator) Start(ctx context.Context, wg *sync.WaitGroup) error {
 	defer func() {
 		agg.wait()
@@ -141,13 +129,26 @@ func (agg *Aggregator) Start(ctx context.Context, wg *sync.WaitGroup) error {
 	agg.logger.Infof("Starting aggregator.")
 	agg.logger.Infof("Starting aggregator rpc server.")
 
+	if err := agg.service.Start(ctx); err != nil {
+		agg.logger.Error("Start aggregator service failed", "err", err)
+		return err
+	}
+
+	go agg.submitter.Start(ctx)
+	agg.startIndexers(ctx)
+
 	agg.startRpcServer(ctx)
 
+	metricsErrChan := agg.service.StartMetrics(ctx)
+
 	agg.logger.Info("Aggregator Rpc Server Started.")
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
+		case err := <-metricsErrChan:
+			agg.logger.Error("The metrics server failed", "err", err)
+			return err
 		case blsAggServiceResp := <-agg.service.GetResponseChannel():
 			agg.logger.Info("Received response from blsAggregationService", "blsAggServiceResp", blsAggServiceResp)
 			agg.sendAggregatedResponseToContract(blsAggServiceResp)
@@ -182,16 +183,38 @@ func (agg *Aggregator) wait() {
 		agg.jsonrpcServer.Wait()
 	}
 
+	if err := agg.service.Close(); err != nil {
+		agg.logger.Error("Close the aggregator service failed", "err", err)
+	}
+
 	agg.logger.Info("The aggregator is exited")
 }
 
 func (agg *Aggregator) sendAggregatedResponseToContract(blsAggServiceResp blsagg.BlsAggregationServiceResponse) {
-	// TODO: check if blsAggServiceResp contains an err
 	if blsAggServiceResp.Err != nil {
 		agg.logger.Error("BlsAggregationServiceResponse contains an error", "err", blsAggServiceResp.Err)
-		// panicing to help with debugging (fail fast), but we shouldn't panic if we run this in production
-		panic(blsAggServiceResp.Err)
+		agg.handleAggregationError(blsAggServiceResp.Err)
+		return
+	}
+
+	task, err := agg.service.GetTaskByIndex(blsAggServiceResp.TaskIndex)
+	if err != nil {
+		agg.logger.Error("Get task failed", "taskIndex", blsAggServiceResp.TaskIndex, "err", err)
+		return
+	}
+	if task == nil {
+		agg.logger.Error("The task not found", "taskIndex", blsAggServiceResp.TaskIndex)
+		return
 	}
+
+	if agg.service.IsTaskExpired(task) {
+		agg.logger.Error("The task had expired, skip send the response", "taskIndex", blsAggServiceResp.TaskIndex)
+		agg.setTaskState(task, store.TaskStateExpired, "expired by block height before quorum reached")
+		return
+	}
+
+	agg.setTaskState(task, store.TaskStateQuorumReached, "")
+
 	nonSignerPubkeys := []csservicemanager.BN254G1Point{}
 	for _, nonSignerPubkey := range blsAggServiceResp.NonSignersPubkeysG1 {
 		nonSignerPubkeys = append(nonSignerPubkeys, core.ConvertToBN254G1Point(nonSignerPubkey))
@@ -215,22 +238,111 @@ func (agg *Aggregator) sendAggregatedResponseToContract(blsAggServiceResp blsagg
 		"taskIndex", blsAggServiceResp.TaskIndex,
 	)
 
-	task := agg.service.GetTaskByIndex(blsAggServiceResp.TaskIndex)
+	err = agg.service.checkAggregatedSignature(context.Background(), task, nonSignerStakesAndSignature)
+	if errors.Is(err, errCheckSignaturesUnavailable) {
+		// the submitter retries the tx, which will fail by the estimating gas if the signature is invalid
+		agg.logger.Warn("The aggregated signature pre-flight check unavailable, submit without it", "taskIndex", task.TaskIndex, "err", err)
+	} else if err != nil {
+		agg.logger.Error("The aggregated signature pre-flight check failed", "taskIndex", task.TaskIndex, "err", err)
+		agg.setTaskState(task, store.TaskStateFailed, fmt.Sprintf("pre-flight check failed: %v", err))
+		return
+	}
+
+	err = agg.submitter.Submit(&ConfirmAlertRequest{
+		Task:                        task,
+		NonSignerStakesAndSignature: nonSignerStakesAndSignature,
+		DeadlineBlock:               agg.service.TaskExpiredBlockNumber(task),
+	})
+	if err != nil {
+		agg.logger.Error("Submit the confirm alert failed", "taskIndex", task.TaskIndex, "err", err)
+		agg.setTaskState(task, store.TaskStateFailed, fmt.Sprintf("submit confirm alert failed: %v", err))
+	}
+}
+
+func (agg *Aggregator) OnConfirmAlertSubmitted(task *message.AlertTaskInfo, txHash common.Hash) {
+	agg.setTaskStatus(task, store.TaskStateSubmitted, "", txHash)
+}
+
+func (agg *Aggregator) OnConfirmAlertFinished(task *message.AlertTaskInfo, res *gethtypes.Receipt, err error) {
+	if errors.Is(err, errAlertAlreadyConfirmed) {
+		agg.logger.Info("The alert already confirmed", "hash", task.AlertHash)
+		if err := agg.service.SetFinishedTask(task, &store.FinishedTaskStatus{Message: task}); err != nil {
+			agg.logger.Error("Save the finished task failed", "hash", task.AlertHash, "err", err)
+		}
+		agg.setTaskState(task, store.TaskStateConfirmed, err.Error())
+		return
+	}
 
-	res, err := agg.avsWriter.SendConfirmAlert(context.Background(), task, nonSignerStakesAndSignature)
 	if err != nil {
 		agg.logger.Error("Aggregator failed to respond to task", "err", err)
+		agg.setTaskState(task, store.TaskStateFailed, fmt.Sprintf("send confirm alert failed: %v", err))
+		return
+	}
+
+	if res.Status != gethtypes.ReceiptStatusSuccessful {
+		agg.logger.Error("the confirm alert tx reverted", "hash", task.AlertHash, "txHash", res.TxHash)
+		agg.setTaskBlockNumber(task, res.BlockNumber)
+		agg.setTaskStatus(task, store.TaskStateFailed, "confirm alert tx reverted", res.TxHash)
+		return
+	}
+
+	err = agg.service.SetFinishedTask(task, &store.FinishedTaskStatus{
+		Message:          task,
+		TxHash:           res.TxHash,
+		BlockHash:        res.BlockHash,
+		BlockNumber:      res.BlockNumber,
+		TransactionIndex: res.TransactionIndex,
+	})
+	if err != nil {
+		agg.logger.Error("Save the finished task failed", "hash", task.AlertHash, "err", err)
+	}
+
+	agg.setTaskBlockNumber(task, res.BlockNumber)
+	agg.setTaskStatus(task, store.TaskStateConfirmed, "", res.TxHash)
+}
+
+func (agg *Aggregator) setTaskBlockNumber(task *message.AlertTaskInfo, blockNumber *big.Int) {
+	if blockNumber == nil {
+		return
+	}
+
+	err := agg.service.updateTaskStatus(task, func(status *store.TaskStatus) {
+		status.BlockNumber = blockNumber.Uint64()
+	})
+	if err != nil {
+		agg.logger.Error("Save the task block number failed", "taskIndex", task.TaskIndex, "err", err)
 	}
+}
+
+func (agg *Aggregator) setTaskState(task *message.AlertTaskInfo, state store.TaskState, reason string) {
+	agg.setTaskStatus(task, state, reason, common.Hash{})
+}
 
-	if res != nil {
-		agg.service.SetFinishedTask(task.AlertHash, &FinishedTaskStatus{
-			Message:          task,
-			TxHash:           res.TxHash,
-			BlockHash:        res.BlockHash,
-			BlockNumber:      res.BlockNumber,
-			TransactionIndex: res.TransactionIndex,
-		})
-	} else {
-		agg.logger.Error("the send confirm alert res is failed by nil return", "hash", task.AlertHash)
+func (agg *Aggregator) setTaskStatus(task *message.AlertTaskInfo, state store.TaskState, reason string, txHash common.Hash) {
+	if err := agg.service.SetTaskStatus(task, state, reason, txHash); err != nil {
+		agg.logger.Error("Set the task state failed", "taskIndex", task.TaskIndex, "state", state, "err", err)
 	}
 }
+
+// handleAggregationError records the error for the task, the error of a task is built by the
+// taskAggregationError which keeps the task index, the others are not belong to any task.
+func (agg *Aggregator) handleAggregationError(aggErr error) {
+	var taskErr *taskAggregationError
+	if !errors.As(aggErr, &taskErr) {
+		agg.logger.Error("The aggregation error not belong to any task", "err", aggErr)
+		return
+	}
+	taskIndex, state := taskErr.taskIndex, taskErr.state
+
+	task, err := agg.service.GetTaskByIndex(taskIndex)
+	if err != nil {
+		agg.logger.Error("Get task failed", "taskIndex", taskIndex, "err", err)
+		return
+	}
+	if task == nil {
+		agg.logger.Error("The task not found", "taskIndex", taskIndex)
+		return
+	}
+
+	agg.setTaskState(task, state, aggErr.Error())
+}
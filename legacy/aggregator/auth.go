@@ -0,0 +1,171 @@
+package aggregator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Layr-Labs/eigensdk-go/crypto/bls"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+	"github.com/Layr-Labs/eigensdk-go/services/operatorsinfo"
+	sdktypes "github.com/Layr-Labs/eigensdk-go/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/alt-research/avs/legacy/core/chainio"
+	"github.com/alt-research/avs/legacy/core/message"
+)
+
+const (
+	// the max difference between the auth timestamp and the aggregator 's time
+	operatorAuthTimestampWindow = 1 * time.Minute
+	// the time to cache the operator registration, so the ejected operator will be rejected after it
+	operatorRegistrationCacheTTL = 1 * time.Minute
+)
+
+type registeredOperator struct {
+	address   common.Address
+	g1Pubkey  *bls.G1Point
+	g2Pubkey  *bls.G2Point
+	checkedAt time.Time
+}
+
+// OperatorAuthenticator checks the requests to aggregator are signed by the operators registered in AVS.
+type OperatorAuthenticator struct {
+	logger        logging.Logger
+	avsReader     chainio.AvsReaderer
+	operatorsInfo operatorsinfo.OperatorsInfoService
+
+	operators   map[sdktypes.OperatorId]*registeredOperator
+	operatorsMu sync.Mutex
+
+	// the auth digests had used, to the time they can be removed
+	usedDigests   map[[32]byte]time.Time
+	usedDigestsMu sync.Mutex
+}
+
+func NewOperatorAuthenticator(
+	logger logging.Logger,
+	avsReader chainio.AvsReaderer,
+	operatorsInfo operatorsinfo.OperatorsInfoService,
+) *OperatorAuthenticator {
+	return &OperatorAuthenticator{
+		logger:        logger,
+		avsReader:     avsReader,
+		operatorsInfo: operatorsInfo,
+		operators:     make(map[sdktypes.OperatorId]*registeredOperator),
+		usedDigests:   make(map[[32]byte]time.Time),
+	}
+}
+
+// Authenticate checks the auth is signed for the method and payload, by the operator which registered in AVS,
+// an auth can only be used once.
+func (a *OperatorAuthenticator) Authenticate(ctx context.Context, method string, payload [32]byte, auth *message.OperatorAuth) error {
+	if auth == nil {
+		return message.NewAggregatorError(message.ErrCodeUnauthorized, "operator auth required")
+	}
+
+	now := time.Now()
+	signedAt := time.Unix(auth.Timestamp, 0)
+	if signedAt.Before(now.Add(-operatorAuthTimestampWindow)) || signedAt.After(now.Add(operatorAuthTimestampWindow)) {
+		return message.NewAggregatorError(message.ErrCodeUnauthorized, "operator auth timestamp %d out of window", auth.Timestamp)
+	}
+
+	operator, err := a.getRegisteredOperator(ctx, auth.OperatorId)
+	if err != nil {
+		return err
+	}
+
+	digest := auth.Digest(method, payload)
+	ok, err := auth.Signature.Verify(operator.g2Pubkey, digest)
+	if err != nil {
+		return fmt.Errorf("verify operator auth signature failed: %w", err)
+	}
+	if !ok {
+		return message.NewAggregatorError(message.ErrCodeUnauthorized, "operator auth signature invalid for operator 0x%x", auth.OperatorId)
+	}
+
+	return a.useDigest(digest, signedAt.Add(operatorAuthTimestampWindow))
+}
+
+// GetOperatorAddress returns the address of the operator registered in AVS.
+func (a *OperatorAuthenticator) GetOperatorAddress(ctx context.Context, operatorId sdktypes.OperatorId) (common.Address, error) {
+	operator, err := a.getRegisteredOperator(ctx, operatorId)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return operator.address, nil
+}
+
+func (a *OperatorAuthenticator) getRegisteredOperator(ctx context.Context, operatorId sdktypes.OperatorId) (*registeredOperator, error) {
+	a.operatorsMu.Lock()
+	operator, ok := a.operators[operatorId]
+	a.operatorsMu.Unlock()
+
+	if ok && time.Since(operator.checkedAt) < operatorRegistrationCacheTTL {
+		return operator, nil
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+
+	address, err := a.avsReader.GetOperatorFromId(opts, operatorId)
+	if err != nil {
+		return nil, fmt.Errorf("get operator 0x%x address failed: %w", operatorId, err)
+	}
+	if address == (common.Address{}) {
+		return nil, message.NewAggregatorError(message.ErrCodeOperatorNotRegistered, "operator 0x%x not registered", operatorId)
+	}
+
+	registered, err := a.avsReader.IsOperatorRegistered(opts, address)
+	if err != nil {
+		return nil, fmt.Errorf("check operator %s registered failed: %w", address.Hex(), err)
+	}
+	if !registered {
+		a.operatorsMu.Lock()
+		delete(a.operators, operatorId)
+		a.operatorsMu.Unlock()
+
+		return nil, message.NewAggregatorError(message.ErrCodeOperatorNotRegistered, "operator %s not registered or ejected", address.Hex())
+	}
+
+	info, found := a.operatorsInfo.GetOperatorInfo(ctx, address)
+	if !found || info.Pubkeys.G1Pubkey == nil || info.Pubkeys.G2Pubkey == nil {
+		return nil, message.NewAggregatorError(message.ErrCodeOperatorNotRegistered, "operator %s pubkeys not found", address.Hex())
+	}
+
+	operator = &registeredOperator{
+		address:   address,
+		g1Pubkey:  info.Pubkeys.G1Pubkey,
+		g2Pubkey:  info.Pubkeys.G2Pubkey,
+		checkedAt: time.Now(),
+	}
+
+	a.operatorsMu.Lock()
+	a.operators[operatorId] = operator
+	a.operatorsMu.Unlock()
+
+	return operator, nil
+}
+
+// useDigest marks the digest used until the expiry, the expired digests will be removed.
+func (a *OperatorAuthenticator) useDigest(digest [32]byte, expiry time.Time) error {
+	a.usedDigestsMu.Lock()
+	defer a.usedDigestsMu.Unlock()
+
+	now := time.Now()
+	for used, usedExpiry := range a.usedDigests {
+		if now.After(usedExpiry) {
+			delete(a.usedDigests, used)
+		}
+	}
+
+	if _, ok := a.usedDigests[digest]; ok {
+		return message.NewAggregatorError(message.ErrCodeUnauthorized, "operator auth replayed")
+	}
+
+	a.usedDigests[digest] = expiry
+
+	return nil
+}
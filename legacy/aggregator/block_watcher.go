@@ -0,0 +1,141 @@
+package aggregator
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/clients/eth"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// the interval to poll the block number when the head subscription is not available
+	blockPollInterval = 3 * time.Second
+	// the time to poll the block number before retry the head subscription
+	subscribeRetryInterval = 1 * time.Minute
+)
+
+// BlockWatcher watches the layer1 block height, it will use the ws head subscription,
+// if the subscription failed, it will fallback to poll the block number by http client,
+// and retry the subscription later.
+type BlockWatcher struct {
+	logger     logging.Logger
+	wsClient   eth.Client
+	httpClient eth.Client
+
+	latestBlockNumber atomic.Uint64
+	onNewBlock        func(blockNumber uint64)
+}
+
+func NewBlockWatcher(logger logging.Logger, wsClient, httpClient eth.Client, onNewBlock func(blockNumber uint64)) *BlockWatcher {
+	return &BlockWatcher{
+		logger:     logger,
+		wsClient:   wsClient,
+		httpClient: httpClient,
+		onNewBlock: onNewBlock,
+	}
+}
+
+// LatestBlockNumber returns the latest block number the watcher seen.
+func (w *BlockWatcher) LatestBlockNumber() uint64 {
+	return w.latestBlockNumber.Load()
+}
+
+// Start fetches the current block number, then watches the new blocks in background until the ctx done.
+func (w *BlockWatcher) Start(ctx context.Context) error {
+	blockNumber, err := w.httpClient.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("get block number failed: %w", err)
+	}
+
+	w.update(blockNumber)
+
+	go w.loop(ctx)
+
+	return nil
+}
+
+func (w *BlockWatcher) loop(ctx context.Context) {
+	for {
+		err := w.watchHeads(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		w.logger.Warn("watch new heads failed, fallback to poll block number", "err", err)
+
+		w.pollBlocks(ctx, subscribeRetryInterval)
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+func (w *BlockWatcher) watchHeads(ctx context.Context) error {
+	if w.wsClient == nil {
+		return fmt.Errorf("no ws client")
+	}
+
+	headers := make(chan *gethtypes.Header)
+	sub, err := w.wsClient.SubscribeNewHead(ctx, headers)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-sub.Err():
+			return err
+		case header := <-headers:
+			w.update(header.Number.Uint64())
+		}
+	}
+}
+
+func (w *BlockWatcher) pollBlocks(ctx context.Context, duration time.Duration) {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	ticker := time.NewTicker(blockPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			return
+		case <-ticker.C:
+			blockNumber, err := w.httpClient.BlockNumber(ctx)
+			if err != nil {
+				w.logger.Warn("poll block number failed", "err", err)
+				continue
+			}
+			w.update(blockNumber)
+		}
+	}
+}
+
+// update will only handle the higher block, the reorged blocks will be ignored.
+func (w *BlockWatcher) update(blockNumber uint64) {
+	for {
+		latest := w.latestBlockNumber.Load()
+		if blockNumber <= latest {
+			return
+		}
+
+		if w.latestBlockNumber.CompareAndSwap(latest, blockNumber) {
+			break
+		}
+	}
+
+	if w.onNewBlock != nil {
+		w.onNewBlock(blockNumber)
+	}
+}
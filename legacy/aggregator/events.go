@@ -0,0 +1,142 @@
+package aggregator
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/alt-research/avs/legacy/aggregator/store"
+	"github.com/alt-research/avs/legacy/core/message"
+)
+
+// the max count of the task events waiting to be sent to the subscribers
+const taskEventsQueueSize = 1024
+
+// errTaskEventsLagging is sent to the subscription which closed by its channel full.
+var errTaskEventsLagging = errors.New("the subscriber is lagging behind the task events")
+
+// the task event type for the task state, the collecting state has no event.
+var taskStateEvents = map[store.TaskState]message.TaskEventType{
+	store.TaskStateCreated:       message.TaskEventCreated,
+	store.TaskStateQuorumReached: message.TaskEventQuorumReached,
+	store.TaskStateSubmitted:     message.TaskEventSubmitted,
+	store.TaskStateConfirmed:     message.TaskEventConfirmed,
+	store.TaskStateExpired:       message.TaskEventExpired,
+	store.TaskStateFailed:        message.TaskEventFailed,
+}
+
+// taskEventSubscription sends the task events to the channel, it will be closed with the
+// errTaskEventsLagging if the channel full, so a slow subscriber will not delay the others.
+type taskEventSubscription struct {
+	agg  *AggregatorService
+	ch   chan<- *message.TaskEvent
+	err  chan error
+	once sync.Once
+}
+
+var _ event.Subscription = (*taskEventSubscription)(nil)
+
+func (s *taskEventSubscription) Err() <-chan error {
+	return s.err
+}
+
+func (s *taskEventSubscription) Unsubscribe() {
+	s.agg.taskEventSubsMu.Lock()
+	defer s.agg.taskEventSubsMu.Unlock()
+
+	s.close(nil)
+}
+
+// close removes the subscription, the err is sent before closing the err channel if not nil,
+// should be called with the taskEventSubsMu held.
+func (s *taskEventSubscription) close(err error) {
+	s.once.Do(func() {
+		delete(s.agg.taskEventSubs, s)
+		if err != nil {
+			s.err <- err
+		}
+		close(s.err)
+	})
+}
+
+// SubscribeTaskEvents subscribes the events of all tasks, the events are sent to the channel without blocking,
+// the subscription is closed with an error if the channel full, the channel should be buffered.
+func (agg *AggregatorService) SubscribeTaskEvents(ch chan<- *message.TaskEvent) event.Subscription {
+	sub := &taskEventSubscription{
+		agg: agg,
+		ch:  ch,
+		err: make(chan error, 1),
+	}
+
+	agg.taskEventSubsMu.Lock()
+	agg.taskEventSubs[sub] = struct{}{}
+	agg.taskEventSubsMu.Unlock()
+
+	return sub
+}
+
+// publishTaskEvent queues the event to send, it will not block the caller,
+// the event will be dropped if the queue is full.
+func (agg *AggregatorService) publishTaskEvent(ev *message.TaskEvent) {
+	ev.Timestamp = time.Now().Unix()
+
+	select {
+	case agg.taskEvents <- ev:
+	default:
+		agg.logger.Warn("the task events queue is full, drop the event", "taskIndex", ev.TaskIndex, "type", ev.Type)
+	}
+}
+
+// publishTaskStatusEvent publishes the event for the task state changed.
+func (agg *AggregatorService) publishTaskStatusEvent(status *store.TaskStatus) {
+	typ, ok := taskStateEvents[status.State]
+	if !ok {
+		return
+	}
+
+	ev := &message.TaskEvent{
+		Type:          typ,
+		TaskIndex:     status.TaskIndex,
+		AlertHash:     status.AlertHash,
+		RollupChainId: status.RollupChainId,
+		Reason:        status.Reason,
+	}
+
+	if status.TxHash != (common.Hash{}) {
+		ev.TxHash = status.TxHash
+		ev.BlockNumber = status.BlockNumber
+	}
+
+	agg.publishTaskEvent(ev)
+}
+
+// sendTaskEvents sends the queued events to the subscribers until the ctx done.
+func (agg *AggregatorService) sendTaskEvents(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev := <-agg.taskEvents:
+			agg.fanOutTaskEvent(ev)
+		}
+	}
+}
+
+// fanOutTaskEvent sends the event to all the subscribers, the subscribers which channel full are closed.
+func (agg *AggregatorService) fanOutTaskEvent(ev *message.TaskEvent) {
+	agg.taskEventSubsMu.Lock()
+	defer agg.taskEventSubsMu.Unlock()
+
+	for sub := range agg.taskEventSubs {
+		select {
+		case sub.ch <- ev:
+		default:
+			agg.logger.Warn("the task events subscriber is lagging, close it", "taskIndex", ev.TaskIndex, "type", ev.Type)
+			sub.close(errTaskEventsLagging)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package aggregator
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/alt-research/avs/legacy/aggregator/store"
+	"github.com/alt-research/avs/legacy/core/chainio"
+
+	csservicemanager "github.com/alt-research/avs/contracts/bindings/MachServiceManager"
+)
+
+// startIndexers follows the confirmed `AlertConfirmed` events of the rollups, so the tasks will be confirmed
+// even if the alert is confirmed by the others or the receipt of the confirm tx is missed.
+func (agg *Aggregator) startIndexers(ctx context.Context) {
+	for _, rollup := range agg.service.rollups {
+		if rollup.indexer == nil {
+			continue
+		}
+
+		rollupChainId := rollup.cfg.ChainId
+		indexer := rollup.indexer
+
+		sub := indexer.SubscribeHandlers(&chainio.ServiceManagerEventHandlers{
+			OnAlertConfirmed: func(ev *csservicemanager.ContractMachServiceManagerAlertConfirmed) {
+				agg.onAlertConfirmed(rollupChainId, ev)
+			},
+		})
+
+		go func() {
+			defer sub.Unsubscribe()
+
+			if err := indexer.Start(ctx); err != nil {
+				agg.logger.Error("The service manager indexer stopped", "rollup", rollupChainId, "err", err)
+			}
+		}()
+	}
+}
+
+// onAlertConfirmed moves the task of the alert to confirmed by the event.
+func (agg *Aggregator) onAlertConfirmed(rollupChainId uint32, ev *csservicemanager.ContractMachServiceManagerAlertConfirmed) {
+	alertHash := common.Hash(ev.MessageHash)
+
+	task, err := agg.service.GetTaskByAlertHash(rollupChainId, ev.MessageHash)
+	if err != nil {
+		agg.logger.Error("Get the task of the confirmed alert failed", "rollup", rollupChainId, "hash", alertHash, "err", err)
+		return
+	}
+
+	if task == nil {
+		agg.logger.Debug("The confirmed alert has no task", "rollup", rollupChainId, "hash", alertHash)
+		return
+	}
+
+	finished, err := agg.service.GetFinishedTaskByAlertHash(rollupChainId, ev.MessageHash)
+	if err != nil {
+		agg.logger.Error("Get the finished task failed", "rollup", rollupChainId, "hash", alertHash, "err", err)
+		return
+	}
+
+	// the finished task saved when the alert already confirmed has no tx
+	if finished != nil && finished.TxHash != (common.Hash{}) {
+		return
+	}
+
+	agg.logger.Info("The alert is confirmed", "rollup", rollupChainId, "hash", alertHash, "taskIndex", task.TaskIndex, "txHash", ev.Raw.TxHash)
+
+	blockNumber := new(big.Int).SetUint64(ev.Raw.BlockNumber)
+	err = agg.service.SetFinishedTask(task, &store.FinishedTaskStatus{
+		Message:          task,
+		TxHash:           ev.Raw.TxHash,
+		BlockHash:        ev.Raw.BlockHash,
+		BlockNumber:      blockNumber,
+		TransactionIndex: ev.Raw.TxIndex,
+	})
+	if err != nil {
+		agg.logger.Error("Save the finished task failed", "hash", alertHash, "err", err)
+	}
+
+	agg.setTaskBlockNumber(task, blockNumber)
+
+	// the task is confirmed without tx if the alert was already confirmed when submitting
+	status, err := agg.service.store.GetTaskStatus(task.TaskIndex)
+	if err != nil {
+		agg.logger.Error("Get the task status failed", "taskIndex", task.TaskIndex, "err", err)
+		return
+	}
+
+	if status != nil && status.State == store.TaskStateConfirmed {
+		if err := agg.service.updateTaskStatus(task, func(status *store.TaskStatus) {
+			status.TxHash = ev.Raw.TxHash
+		}); err != nil {
+			agg.logger.Error("Save the task tx hash failed", "taskIndex", task.TaskIndex, "err", err)
+		}
+		return
+	}
+
+	agg.setTaskStatus(task, store.TaskStateConfirmed, "", ev.Raw.TxHash)
+}
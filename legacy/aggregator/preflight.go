@@ -0,0 +1,101 @@
+package aggregator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	csservicemanager "github.com/alt-research/avs/contracts/bindings/MachServiceManager"
+	"github.com/alt-research/avs/legacy/aggregator/store"
+	"github.com/alt-research/avs/legacy/core/chainio"
+	"github.com/alt-research/avs/legacy/core/message"
+)
+
+// the denominator for the quorum threshold percentages, same as the `THRESHOLD_DENOMINATOR` in contracts.
+const thresholdDenominator = 100
+
+// errCheckSignaturesUnavailable is returned if the `checkSignatures` call failed without revert,
+// the aggregated signature may be still valid, so the task should not be failed by it.
+var errCheckSignaturesUnavailable = errors.New("check signatures call failed")
+
+// checkAggregatedSignature dry-runs the `checkSignatures` for the task, then checks the signed stake for
+// each quorum is reached the threshold, so we will not spend gas for a tx which will be reverted.
+func (agg *AggregatorService) checkAggregatedSignature(
+	ctx context.Context,
+	task *message.AlertTaskInfo,
+	nonSignerStakesAndSignature csservicemanager.IBLSSignatureCheckerNonSignerStakesAndSignature,
+) error {
+	rollup, err := agg.getRollup(task.RollupChainId)
+	if err != nil {
+		return err
+	}
+
+	msgHash, err := task.SignHash()
+	if err != nil {
+		return err
+	}
+
+	// the contract requires the reference block number less than the current block,
+	// so the call is made at the latest block with the task's reference block number.
+	stakeTotals, err := rollup.avsReader.CheckSignatures(
+		ctx, msgHash, task.QuorumNumbers.UnderlyingType(), uint32(task.ReferenceBlockNumber), nonSignerStakesAndSignature,
+	)
+	if err != nil {
+		if !chainio.IsRevertError(err) {
+			return fmt.Errorf("%w: %v", errCheckSignaturesUnavailable, err)
+		}
+		return fmt.Errorf("check signatures reverted: %s", chainio.DecodeRevertReason(err))
+	}
+
+	if len(stakeTotals.SignedStakeForQuorum) != len(task.QuorumThresholdPercentages) ||
+		len(stakeTotals.TotalStakeForQuorum) != len(task.QuorumThresholdPercentages) {
+		return fmt.Errorf(
+			"the stake totals len %d not match the quorums len %d",
+			len(stakeTotals.SignedStakeForQuorum), len(task.QuorumThresholdPercentages),
+		)
+	}
+
+	signedStakePercentages := make([]uint8, len(stakeTotals.SignedStakeForQuorum))
+	for i := range stakeTotals.SignedStakeForQuorum {
+		signedStakePercentages[i] = signedStakePercentage(stakeTotals.SignedStakeForQuorum[i], stakeTotals.TotalStakeForQuorum[i])
+	}
+
+	err = agg.updateTaskStatus(task, func(status *store.TaskStatus) {
+		status.SignedStakePercentages = signedStakePercentages
+	})
+	if err != nil {
+		agg.logger.Error("save the signed stake percentages failed", "taskIndex", task.TaskIndex, "err", err)
+	}
+
+	for i, thresholdPercentage := range task.QuorumThresholdPercentages {
+		// signedStakeForQuorum[i] * THRESHOLD_DENOMINATOR >= totalStakeForQuorum[i] * quorumThresholdPercentages[i]
+		signed := new(big.Int).Mul(stakeTotals.SignedStakeForQuorum[i], big.NewInt(thresholdDenominator))
+		required := new(big.Int).Mul(stakeTotals.TotalStakeForQuorum[i], big.NewInt(int64(thresholdPercentage)))
+
+		if signed.Cmp(required) < 0 {
+			return fmt.Errorf(
+				"the signed stake %s of quorum %d not reach the threshold %d%% of total stake %s",
+				stakeTotals.SignedStakeForQuorum[i], task.QuorumNumbers[i], thresholdPercentage, stakeTotals.TotalStakeForQuorum[i],
+			)
+		}
+	}
+
+	return nil
+}
+
+// signedStakePercentage returns signed * THRESHOLD_DENOMINATOR / total, 0 if the total is zero.
+func signedStakePercentage(signed, total *big.Int) uint8 {
+	if total == nil || total.Sign() == 0 {
+		return 0
+	}
+
+	res := new(big.Int).Mul(signed, big.NewInt(thresholdDenominator))
+	res.Div(res, total)
+
+	if !res.IsUint64() || res.Uint64() > thresholdDenominator {
+		return thresholdDenominator
+	}
+
+	return uint8(res.Uint64())
+}
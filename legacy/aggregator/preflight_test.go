@@ -0,0 +1,97 @@
+package aggregator
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	sdklogging "github.com/Layr-Labs/eigensdk-go/logging"
+	sdktypes "github.com/Layr-Labs/eigensdk-go/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"go.uber.org/mock/gomock"
+
+	csservicemanager "github.com/alt-research/avs/contracts/bindings/MachServiceManager"
+	"github.com/alt-research/avs/legacy/core/chainio/mocks"
+	"github.com/alt-research/avs/legacy/core/config"
+	"github.com/alt-research/avs/legacy/core/message"
+)
+
+// revertError is the error of a reverted call, same as the `rpc.DataError` returned by geth.
+type revertError struct {
+	data string
+}
+
+func (e *revertError) Error() string {
+	return "execution reverted"
+}
+
+func (e *revertError) ErrorData() interface{} {
+	return e.data
+}
+
+func TestCheckAggregatedSignatureErrors(t *testing.T) {
+	cases := []struct {
+		name        string
+		err         error
+		unavailable bool
+		reason      string
+	}{
+		{
+			name:   "reverted by custom error",
+			err:    &revertError{data: hexutil.Encode(crypto.Keccak256([]byte("InvalidReferenceBlockNum()"))[:4])},
+			reason: "InvalidReferenceBlockNum",
+		},
+		{
+			name:        "transport error",
+			err:         errors.New("Post \"http://localhost:8545\": dial tcp: connection refused"),
+			unavailable: true,
+		},
+		{
+			name:        "timeout",
+			err:         context.DeadlineExceeded,
+			unavailable: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			avsReader := mocks.NewMockAvsReaderer(ctrl)
+			avsReader.EXPECT().
+				CheckSignatures(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(csservicemanager.IBLSSignatureCheckerQuorumStakeTotals{}, c.err)
+
+			agg := &AggregatorService{
+				logger: sdklogging.NewNoopLogger(),
+				rollups: map[uint32]*rollupChain{
+					42: {cfg: &config.RollupConfig{ChainId: 42}, avsReader: avsReader},
+				},
+			}
+
+			task := &message.AlertTaskInfo{
+				AlertHash:                  [32]byte{1},
+				QuorumNumbers:              sdktypes.QuorumNums{0},
+				QuorumThresholdPercentages: sdktypes.QuorumThresholdPercentages{66},
+				TaskIndex:                  1,
+				ReferenceBlockNumber:       100,
+				RollupChainId:              42,
+			}
+
+			err := agg.checkAggregatedSignature(context.Background(), task, csservicemanager.IBLSSignatureCheckerNonSignerStakesAndSignature{})
+			if err == nil {
+				t.Fatalf("the check should be failed")
+			}
+
+			if errors.Is(err, errCheckSignaturesUnavailable) != c.unavailable {
+				t.Fatalf("expect unavailable %v, got %v", c.unavailable, err)
+			}
+
+			if c.reason != "" && !strings.Contains(err.Error(), c.reason) {
+				t.Fatalf("expect the revert reason %s, got %v", c.reason, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package aggregator
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/alt-research/avs/legacy/core/chainio"
+	"github.com/alt-research/avs/legacy/core/config"
+	"github.com/alt-research/avs/legacy/core/message"
+)
+
+// rollupChain is a rollup served by the aggregator, with the clients for its service manager.
+type rollupChain struct {
+	cfg       *config.RollupConfig
+	avsReader chainio.AvsReaderer
+	avsWriter chainio.AvsWriterer
+	// index the events of the service manager, nil if no ws client
+	indexer *chainio.ServiceManagerIndexer
+}
+
+// newRollupChains builds the clients for each rollup in config, the rollups are keyed by the chain id.
+func newRollupChains(c *config.Config) (map[uint32]*rollupChain, error) {
+	res := make(map[uint32]*rollupChain, len(c.Rollups))
+
+	for _, rollup := range c.Rollups {
+		avsReader, err := chainio.BuildAvsReaderForRollup(c, rollup)
+		if err != nil {
+			return nil, fmt.Errorf("build avs reader for rollup %d failed: %w", rollup.ChainId, err)
+		}
+
+		avsWriter, err := chainio.BuildAvsWriterForRollup(c, rollup)
+		if err != nil {
+			return nil, fmt.Errorf("build avs writer for rollup %d failed: %w", rollup.ChainId, err)
+		}
+
+		var indexer *chainio.ServiceManagerIndexer
+		if c.EthWsClient != nil {
+			indexer, err = chainio.BuildServiceManagerIndexer(
+				rollup.ServiceManagerAddr, c.RegistryCoordinatorAddr, c.OperatorStateRetrieverAddr, c.EthWsClient,
+				rollupIndexerConfig(c, rollup.ChainId), c.Logger.With("rollup", rollup.ChainId),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("build indexer for rollup %d failed: %w", rollup.ChainId, err)
+			}
+		}
+
+		res[rollup.ChainId] = &rollupChain{
+			cfg:       rollup,
+			avsReader: avsReader,
+			avsWriter: avsWriter,
+			indexer:   indexer,
+		}
+	}
+
+	return res, nil
+}
+
+// rollupIndexerConfig returns the indexer config for the rollup, each rollup has its own checkpoint
+// in the `indexer_checkpoint_path` directory.
+func rollupIndexerConfig(c *config.Config, rollupChainId uint32) chainio.IndexerConfig {
+	cfg := chainio.IndexerConfig{
+		StartBlock:        c.IndexerStartBlock,
+		ConfirmationDepth: c.IndexerConfirmationDepth,
+	}
+
+	if c.IndexerCheckpointPath != "" {
+		cfg.CheckpointPath = filepath.Join(c.IndexerCheckpointPath, fmt.Sprintf("rollup-%d.json", rollupChainId))
+	}
+
+	return cfg
+}
+
+// getRollup returns the rollup by the chain id, 0 means the default rollup.
+func (agg *AggregatorService) getRollup(rollupChainId uint32) (*rollupChain, error) {
+	if rollupChainId == 0 {
+		rollupChainId = agg.cfg.Layer2ChainId
+	}
+
+	rollup, ok := agg.rollups[rollupChainId]
+	if !ok {
+		return nil, message.NewAggregatorError(message.ErrCodeRollupNotSupported, "rollup %d not served by the aggregator", rollupChainId)
+	}
+
+	return rollup, nil
+}
+
+// rollupChainIds returns the chain ids of the rollups served by the aggregator.
+func (agg *AggregatorService) rollupChainIds() []uint32 {
+	res := make([]uint32, 0, len(agg.rollups))
+	for chainId := range agg.rollups {
+		res = append(res, chainId)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+
+	return res
+}
@@ -0,0 +1,48 @@
+package rpc
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alt-research/avs/legacy/core/message"
+)
+
+// the gRPC status code for the aggregator errors
+var aggregatorErrorGRpcCodes = map[message.AggregatorErrorCode]codes.Code{
+	message.ErrCodeUnauthorized:           codes.Unauthenticated,
+	message.ErrCodeOperatorNotInitialized: codes.FailedPrecondition,
+	message.ErrCodeOperatorNotRegistered:  codes.PermissionDenied,
+	message.ErrCodeTaskNotFound:           codes.NotFound,
+	message.ErrCodeTaskMismatch:           codes.InvalidArgument,
+	message.ErrCodeTaskTerminated:         codes.FailedPrecondition,
+	message.ErrCodeInvalidSignature:       codes.InvalidArgument,
+	message.ErrCodeDuplicateSignature:     codes.AlreadyExists,
+	message.ErrCodeTaskFinished:           codes.AlreadyExists,
+	message.ErrCodeRollupNotSupported:     codes.InvalidArgument,
+}
+
+// wrapGRpcError keeps the aggregator error in the message with a matched gRPC status code.
+func wrapGRpcError(method string, err error) error {
+	aggErr, ok := err.(*message.AggregatorError)
+	if !ok {
+		return fmt.Errorf("%s handler error: %v", method, err.Error())
+	}
+
+	code, ok := aggregatorErrorGRpcCodes[aggErr.Code]
+	if !ok {
+		code = codes.Unknown
+	}
+
+	return status.Errorf(code, "%s handler error: %v", method, aggErr.Error())
+}
+
+// wrapJsonRpcError returns the aggregator error as it is, so its code will be used as the JSON RPC error code.
+func wrapJsonRpcError(method string, err error) error {
+	if aggErr, ok := err.(*message.AggregatorError); ok {
+		return aggErr
+	}
+
+	return fmt.Errorf("%s process request falied: %v", method, err)
+}
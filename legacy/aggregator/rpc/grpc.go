@@ -2,14 +2,27 @@ package rpc
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net"
+	"os"
 	"sync"
+	"time"
 
 	"github.com/Layr-Labs/eigensdk-go/logging"
 	"github.com/alt-research/avs/legacy/api/grpc/aggregator"
+	"github.com/alt-research/avs/legacy/core/config"
 	"github.com/alt-research/avs/legacy/core/message"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
 type GRpcHandler struct {
@@ -17,14 +30,116 @@ type GRpcHandler struct {
 	logger     logging.Logger
 	aggreagtor AggregatorRpcHandler
 	wg         *sync.WaitGroup
+
+	server       *grpc.Server
+	health       *health.Server
+	drainTimeout time.Duration
+	// closed when the server stopping, to end the streams which never finish by themselves
+	stopping chan struct{}
 }
 
-func NewGRpcHandler(logger logging.Logger, aggreagtor AggregatorRpcHandler) *GRpcHandler {
-	return &GRpcHandler{
-		logger:     logger,
-		aggreagtor: aggreagtor,
-		wg:         &sync.WaitGroup{},
+// NewGRpcHandler creates the gRPC server with the TLS, keepalive and interceptors by the config,
+// the operators are used to check the pinned client certificates, can be nil if no certificate pinned.
+func NewGRpcHandler(
+	logger logging.Logger,
+	aggreagtor AggregatorRpcHandler,
+	cfg config.GRPCServerConfig,
+	metrics GRpcServerMetrics,
+	operators OperatorAddressResolver,
+) (*GRpcHandler, error) {
+	creds, err := gRpcServerCredentials(cfg.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("load the grpc server tls failed: %w", err)
+	}
+
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		recoveryUnaryInterceptor(logger),
+		observeUnaryInterceptor(logger, metrics),
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		recoveryStreamInterceptor(logger),
+		observeStreamInterceptor(logger, metrics),
+	}
+
+	fingerprints, err := cfg.TLS.OperatorCertFingerprints()
+	if err != nil {
+		return nil, err
+	}
+	if len(fingerprints) != 0 {
+		if operators == nil {
+			return nil, fmt.Errorf("the operator address resolver is required to pin the operator certificates")
+		}
+		pinner := newOperatorCertPinner(operators, fingerprints)
+		unaryInterceptors = append(unaryInterceptors, pinner.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, pinner.streamInterceptor)
 	}
+
+	server := grpc.NewServer(
+		grpc.Creds(creds),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             cfg.KeepaliveMinTime,
+			PermitWithoutStream: true,
+		}),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    cfg.KeepaliveTime,
+			Timeout: cfg.KeepaliveTimeout,
+		}),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
+
+	s := &GRpcHandler{
+		logger:       logger,
+		aggreagtor:   aggreagtor,
+		wg:           &sync.WaitGroup{},
+		server:       server,
+		health:       health.NewServer(),
+		drainTimeout: cfg.DrainTimeout,
+		stopping:     make(chan struct{}),
+	}
+
+	aggregator.RegisterAggregatorServer(server, s)
+	healthpb.RegisterHealthServer(server, s.health)
+	if cfg.EnableReflection {
+		logger.Info("Enable the grpc reflection service")
+		reflection.Register(server)
+	}
+
+	return s, nil
+}
+
+// gRpcServerCredentials returns the insecure credentials if the TLS not enabled,
+// the client certificates are required and verified if the client ca set.
+func gRpcServerCredentials(c config.GRPCServerTLSConfig) (credentials.TransportCredentials, error) {
+	if !c.Enabled() {
+		return insecure.NewCredentials(), nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.CertPath, c.KeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("load the server certificate failed: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+	}
+
+	if c.ClientCaPath != "" {
+		caPem, err := os.ReadFile(c.ClientCaPath)
+		if err != nil {
+			return nil, fmt.Errorf("read the client ca %s failed: %w", c.ClientCaPath, err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPem) {
+			return nil, fmt.Errorf("no certificate in the client ca %s", c.ClientCaPath)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
 }
 
 func (s *GRpcHandler) StartServer(ctx context.Context, serverIpPortAddr string) {
@@ -35,21 +150,22 @@ func (s *GRpcHandler) StartServer(ctx context.Context, serverIpPortAddr string)
 		s.logger.Fatalf("GRpcServer failed to listen: %v", err)
 	}
 
-	server := grpc.NewServer()
-	aggregator.RegisterAggregatorServer(server, s)
-
 	serverErr := make(chan error, 1)
 
 	s.wg.Add(1)
 	go func() {
 		defer s.wg.Done()
-		serverErr <- server.Serve(lis)
+		serverErr <- s.server.Serve(lis)
 	}()
 
+	s.health.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	s.health.SetServingStatus(aggregator.Aggregator_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+
 	select {
 	case <-ctx.Done():
 		s.logger.Info("Stop GRpcServer by Done")
-		server.Stop()
+		s.stop()
+		err = <-serverErr
 	case err = <-serverErr:
 	}
 
@@ -60,6 +176,26 @@ func (s *GRpcHandler) StartServer(ctx context.Context, serverIpPortAddr string)
 	}
 }
 
+// stop marks the services not serving, then waits the pending requests finished until the drain timeout,
+// the connections will be closed after that.
+func (s *GRpcHandler) stop() {
+	s.health.Shutdown()
+	close(s.stopping)
+
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(s.drainTimeout):
+		s.logger.Warn("GRpcServer drain timeout, close the connections", "timeout", s.drainTimeout)
+		s.server.Stop()
+	}
+}
+
 func (s *GRpcHandler) Wait() {
 	s.wg.Wait()
 }
@@ -73,7 +209,7 @@ func (s *GRpcHandler) InitOperator(ctx context.Context, req *aggregator.InitOper
 
 	resp, err := s.aggreagtor.InitOperator(msg)
 	if err != nil {
-		return nil, fmt.Errorf("initOperator handler error: %v", err.Error())
+		return nil, wrapGRpcError("initOperator", err)
 	}
 
 	return resp.ToPbType(), nil
@@ -88,7 +224,7 @@ func (s *GRpcHandler) CreateTask(ctx context.Context, req *aggregator.CreateTask
 
 	resp, err := s.aggreagtor.CreateTask(msg)
 	if err != nil {
-		return nil, fmt.Errorf("createTask handler error: %v", err.Error())
+		return nil, wrapGRpcError("createTask", err)
 	}
 
 	return resp.ToPbType(), nil
@@ -103,8 +239,77 @@ func (s *GRpcHandler) ProcessSignedTaskResponse(ctx context.Context, req *aggreg
 
 	resp, err := s.aggreagtor.ProcessSignedTaskResponse(msg)
 	if err != nil {
-		return nil, fmt.Errorf("processSignedTaskResponse handler error: %v", err.Error())
+		return nil, wrapGRpcError("processSignedTaskResponse", err)
 	}
 
 	return resp.ToPbType(), nil
 }
+
+// Get the status of a task by alert hash or task index
+func (s *GRpcHandler) GetTaskStatus(ctx context.Context, req *aggregator.GetTaskStatusRequest) (*aggregator.TaskStatus, error) {
+	msg, err := message.NewGetTaskStatusRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("getTaskStatus message convert error: %v", err.Error())
+	}
+
+	resp, err := s.aggreagtor.GetTaskStatus(msg)
+	if err != nil {
+		return nil, wrapGRpcError("getTaskStatus", err)
+	}
+
+	return resp.ToPbType(), nil
+}
+
+// List the tasks status by filter
+func (s *GRpcHandler) ListTasks(ctx context.Context, req *aggregator.ListTasksRequest) (*aggregator.ListTasksResponse, error) {
+	msg, err := message.NewListTasksRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("listTasks message convert error: %v", err.Error())
+	}
+
+	resp, err := s.aggreagtor.ListTasks(msg)
+	if err != nil {
+		return nil, wrapGRpcError("listTasks", err)
+	}
+
+	return resp.ToPbType(), nil
+}
+
+// the buffer size of the events channel for each subscriber
+const taskEventsBufferSize = 128
+
+// Subscribe the events of the tasks
+func (s *GRpcHandler) SubscribeTaskEvents(req *aggregator.SubscribeTaskEventsRequest, stream aggregator.Aggregator_SubscribeTaskEventsServer) error {
+	msg, err := message.NewSubscribeTaskEventsRequest(req)
+	if err != nil {
+		return fmt.Errorf("subscribeTaskEvents message convert error: %v", err.Error())
+	}
+
+	events := make(chan *message.TaskEvent, taskEventsBufferSize)
+	sub := s.aggreagtor.SubscribeTaskEvents(events)
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-s.stopping:
+			return status.Error(codes.Unavailable, "the aggregator is stopping")
+		case err := <-sub.Err():
+			if err != nil {
+				// the subscriber is closed by lagging behind the events
+				return status.Error(codes.ResourceExhausted, err.Error())
+			}
+			return nil
+		case ev := <-events:
+			if !msg.Match(ev) {
+				continue
+			}
+
+			if err := stream.Send(ev.ToPbType()); err != nil {
+				s.logger.Warn("send task event failed", "err", err)
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,209 @@
+package rpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/Layr-Labs/eigensdk-go/logging"
+	sdktypes "github.com/Layr-Labs/eigensdk-go/types"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/alt-research/avs/legacy/api/grpc/aggregator"
+)
+
+// GRpcServerMetrics records the requests handled by the gRPC server.
+type GRpcServerMetrics interface {
+	ObserveGRpcRequest(method string, code string, duration time.Duration)
+}
+
+// OperatorAddressResolver resolves the address of the operator registered in AVS.
+type OperatorAddressResolver interface {
+	GetOperatorAddress(ctx context.Context, operatorId sdktypes.OperatorId) (common.Address, error)
+}
+
+// recoveryUnaryInterceptor returns the Internal error instead of crashing the aggregator if the handler panic.
+func recoveryUnaryInterceptor(logger logging.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error("GRpc handler panic", "method", info.FullMethod, "panic", r, "stack", string(debug.Stack()))
+				err = status.Errorf(codes.Internal, "%s handler panic", info.FullMethod)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+func recoveryStreamInterceptor(logger logging.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error("GRpc stream handler panic", "method", info.FullMethod, "panic", r, "stack", string(debug.Stack()))
+				err = status.Errorf(codes.Internal, "%s handler panic", info.FullMethod)
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
+
+// observeUnaryInterceptor logs the requests and records them to the metrics.
+func observeUnaryInterceptor(logger logging.Logger, metrics GRpcServerMetrics) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observeGRpcRequest(ctx, logger, metrics, info.FullMethod, start, err)
+
+		return resp, err
+	}
+}
+
+func observeStreamInterceptor(logger logging.Logger, metrics GRpcServerMetrics) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		observeGRpcRequest(ss.Context(), logger, metrics, info.FullMethod, start, err)
+
+		return err
+	}
+}
+
+func observeGRpcRequest(ctx context.Context, logger logging.Logger, metrics GRpcServerMetrics, method string, start time.Time, err error) {
+	duration := time.Since(start)
+	code := status.Code(err)
+
+	remote := ""
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		remote = p.Addr.String()
+	}
+
+	if err != nil {
+		logger.Warn("GRpc request failed", "method", method, "code", code.String(), "remote", remote, "duration", duration, "err", err)
+	} else {
+		logger.Debug("GRpc request handled", "method", method, "remote", remote, "duration", duration)
+	}
+
+	if metrics != nil {
+		metrics.ObserveGRpcRequest(method, code.String(), duration)
+	}
+}
+
+// operatorAuthRequest is the request with the operator auth.
+type operatorAuthRequest interface {
+	GetAuth() *aggregator.OperatorAuth
+}
+
+// operatorCertPinner checks the client certificate of the request is pinned for the operator in its auth,
+// so a leaked certificate can not be used by the other operators. The streams have no operator auth,
+// so their certificates should be pinned for any operator.
+type operatorCertPinner struct {
+	resolver OperatorAddressResolver
+	// the operator address to the sha256 fingerprints of its certificates
+	fingerprints map[common.Address][]string
+	// all the pinned fingerprints
+	pinned map[string]struct{}
+}
+
+func newOperatorCertPinner(resolver OperatorAddressResolver, fingerprints map[common.Address][]string) *operatorCertPinner {
+	pinned := make(map[string]struct{})
+	for _, fps := range fingerprints {
+		for _, fingerprint := range fps {
+			pinned[fingerprint] = struct{}{}
+		}
+	}
+
+	return &operatorCertPinner{
+		resolver:     resolver,
+		fingerprints: fingerprints,
+		pinned:       pinned,
+	}
+}
+
+func (p *operatorCertPinner) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	// the request without auth will be rejected by the handler
+	if authReq, ok := req.(operatorAuthRequest); ok && authReq.GetAuth() != nil {
+		if err := p.check(ctx, authReq.GetAuth().GetOperatorId()); err != nil {
+			return nil, err
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+func (p *operatorCertPinner) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	// the reflection service is not pinned, same as the unary health service
+	if strings.HasPrefix(info.FullMethod, "/"+aggregator.Aggregator_ServiceDesc.ServiceName+"/") {
+		if err := p.checkPinned(ss.Context()); err != nil {
+			return err
+		}
+	}
+
+	return handler(srv, ss)
+}
+
+// checkPinned checks the client certificate is pinned for any operator.
+func (p *operatorCertPinner) checkPinned(ctx context.Context) error {
+	fingerprint, err := peerCertFingerprint(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := p.pinned[fingerprint]; !ok {
+		return status.Errorf(codes.PermissionDenied, "the client certificate %s not pinned for any operator", fingerprint)
+	}
+
+	return nil
+}
+
+func (p *operatorCertPinner) check(ctx context.Context, rawOperatorId []byte) error {
+	if len(rawOperatorId) != len(sdktypes.OperatorId{}) {
+		return status.Errorf(codes.InvalidArgument, "invalid operator id length %d", len(rawOperatorId))
+	}
+
+	var operatorId sdktypes.OperatorId
+	copy(operatorId[:], rawOperatorId)
+
+	fingerprint, err := peerCertFingerprint(ctx)
+	if err != nil {
+		return err
+	}
+
+	address, err := p.resolver.GetOperatorAddress(ctx, operatorId)
+	if err != nil {
+		return wrapGRpcError("checkOperatorCert", err)
+	}
+
+	for _, pinned := range p.fingerprints[address] {
+		if pinned == fingerprint {
+			return nil
+		}
+	}
+
+	return status.Errorf(codes.PermissionDenied, "the client certificate %s not pinned for operator %s", fingerprint, address.Hex())
+}
+
+// peerCertFingerprint returns the sha256 fingerprint of the client certificate verified by the mTLS.
+func peerCertFingerprint(ctx context.Context) (string, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "no peer info")
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return "", status.Error(codes.Unauthenticated, "client certificate required")
+	}
+
+	sum := sha256.Sum256(tlsInfo.State.PeerCertificates[0].Raw)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -1,6 +1,8 @@
 package rpc
 
 import (
+	"github.com/ethereum/go-ethereum/event"
+
 	"github.com/alt-research/avs/legacy/core/message"
 )
 
@@ -8,4 +10,7 @@ type AggregatorRpcHandler interface {
 	InitOperator(req *message.InitOperatorRequest) (*message.InitOperatorResponse, error)
 	CreateTask(req *message.CreateTaskRequest) (*message.CreateTaskResponse, error)
 	ProcessSignedTaskResponse(signedTaskResponse *message.SignedTaskRespRequest) (*message.SignedTaskRespResponse, error)
+	GetTaskStatus(req *message.GetTaskStatusRequest) (*message.TaskStatus, error)
+	ListTasks(req *message.ListTasksRequest) (*message.ListTasksResponse, error)
+	SubscribeTaskEvents(ch chan<- *message.TaskEvent) event.Subscription
 }
@@ -3,6 +3,8 @@ package rpc
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strings"
 	"sync"
 
 	"github.com/Layr-Labs/eigensdk-go/logging"
@@ -51,7 +53,16 @@ func (s *JsonRpcServer) StartServer(ctx context.Context, serverIpPortAddr string
 	if err != nil {
 		s.logger.Fatalf("Could not register API: %w", err)
 	}
-	handler := node.NewHTTPHandlerStack(srv, s.cors, s.vhosts, nil)
+	// the websocket is used for the subscriptions, it will use the same port with http.
+	httpHandler := node.NewHTTPHandlerStack(srv, s.cors, s.vhosts, nil)
+	wsHandler := node.NewWSHandlerStack(srv.WebsocketHandler(s.cors), nil)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isWebsocket(r) {
+			wsHandler.ServeHTTP(w, r)
+			return
+		}
+		httpHandler.ServeHTTP(w, r)
+	})
 
 	httpServer, addr, err := node.StartHTTPEndpoint(serverIpPortAddr, gethrpc.DefaultHTTPTimeouts, handler)
 	if err != nil {
@@ -87,11 +98,38 @@ func (s *JsonRpcServer) Wait() {
 	s.wg.Wait()
 }
 
+// isWebsocket checks the header of the http request for a websocket upgrade request.
+func isWebsocket(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket") &&
+		strings.Contains(strings.ToLower(r.Header.Get("Connection")), "upgrade")
+}
+
 type JsonRpcHandler struct {
 	logger     logging.Logger
 	aggreagtor AggregatorRpcHandler
 }
 
+type OperatorAuth struct {
+	// The operator 's id
+	OperatorId hexutil.Bytes `json:"operator_id"`
+	// The unix time when the request signed
+	Timestamp int64 `json:"timestamp"`
+	// The operator 's BLS signature on the auth digest
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+func (a *OperatorAuth) toPbType() *aggregator.OperatorAuth {
+	if a == nil {
+		return nil
+	}
+
+	return &aggregator.OperatorAuth{
+		OperatorId: a.OperatorId,
+		Timestamp:  a.Timestamp,
+		Signature:  a.Signature,
+	}
+}
+
 type InitOperatorResponse struct {
 	Ok     bool   `json:"ok"`
 	Reason string `json:"reason"`
@@ -105,6 +143,7 @@ func (h *JsonRpcHandler) InitOperator(
 	operatorAddress string,
 	operatorStateRetrieverAddr string,
 	registryCoordinatorAddr string,
+	auth *OperatorAuth,
 ) (InitOperatorResponse, error) {
 	req, err := message.NewInitOperatorRequest(&aggregator.InitOperatorRequest{
 		Layer1ChainId:              layer1ChainId,
@@ -113,6 +152,7 @@ func (h *JsonRpcHandler) InitOperator(
 		OperatorAddress:            operatorAddress,
 		OperatorStateRetrieverAddr: operatorStateRetrieverAddr,
 		RegistryCoordinatorAddr:    registryCoordinatorAddr,
+		Auth:                       auth.toPbType(),
 	})
 	if err != nil {
 		return InitOperatorResponse{}, fmt.Errorf("initOperator parse request falied: %v", err)
@@ -120,7 +160,7 @@ func (h *JsonRpcHandler) InitOperator(
 
 	res, err := h.aggreagtor.InitOperator(req)
 	if err != nil {
-		return InitOperatorResponse{}, fmt.Errorf("initOperator process request falied: %v", err)
+		return InitOperatorResponse{}, wrapJsonRpcError("initOperator", err)
 	}
 
 	resp := InitOperatorResponse{
@@ -142,35 +182,59 @@ type AlertTaskInfo struct {
 	TaskIndex uint32 `json:"task_index"`
 	// ReferenceBlockNumber
 	ReferenceBlockNumber uint64 `json:"reference_block_number"`
+	// The rollup chain id of alert
+	RollupChainId uint32 `json:"rollup_chain_id"`
+}
+
+func newAlertTaskInfo(info *aggregator.AlertTaskInfo) AlertTaskInfo {
+	return AlertTaskInfo{
+		AlertHash:                  info.AlertHash,
+		QuorumNumbers:              info.QuorumNumbers,
+		QuorumThresholdPercentages: info.QuorumThresholdPercentages,
+		TaskIndex:                  info.TaskIndex,
+		ReferenceBlockNumber:       info.ReferenceBlockNumber,
+		RollupChainId:              info.RollupChainId,
+	}
 }
 
+func (a AlertTaskInfo) toPbType() *aggregator.AlertTaskInfo {
+	return &aggregator.AlertTaskInfo{
+		AlertHash:                  a.AlertHash,
+		QuorumNumbers:              a.QuorumNumbers,
+		QuorumThresholdPercentages: a.QuorumThresholdPercentages,
+		TaskIndex:                  a.TaskIndex,
+		ReferenceBlockNumber:       a.ReferenceBlockNumber,
+		RollupChainId:              a.RollupChainId,
+	}
+}
+
+// CreateTask creates the task for the alert in the rollup, the rollupChainId is optional,
+// the default rollup of the aggregator will be used if not set.
 func (h *JsonRpcHandler) CreateTask(
 	ctx context.Context,
 	alertHash hexutil.Bytes,
+	auth *OperatorAuth,
+	rollupChainId *uint32,
 ) (AlertTaskInfo, error) {
-	req, err := message.NewCreateTaskRequest(&aggregator.CreateTaskRequest{
+	pbReq := &aggregator.CreateTaskRequest{
 		AlertHash: alertHash,
-	})
+		Auth:      auth.toPbType(),
+	}
+	if rollupChainId != nil {
+		pbReq.RollupChainId = *rollupChainId
+	}
+
+	req, err := message.NewCreateTaskRequest(pbReq)
 	if err != nil {
 		return AlertTaskInfo{}, fmt.Errorf("createTask parse request falied: %v", err)
 	}
 
 	res, err := h.aggreagtor.CreateTask(req)
 	if err != nil {
-		return AlertTaskInfo{}, fmt.Errorf("createTask process request falied: %v", err)
+		return AlertTaskInfo{}, wrapJsonRpcError("createTask", err)
 	}
 
-	info := res.Info.ToPbType()
-
-	resp := AlertTaskInfo{
-		AlertHash:                  info.AlertHash,
-		QuorumNumbers:              info.QuorumNumbers,
-		QuorumThresholdPercentages: info.QuorumThresholdPercentages,
-		TaskIndex:                  info.TaskIndex,
-		ReferenceBlockNumber:       info.ReferenceBlockNumber,
-	}
-
-	return resp, nil
+	return newAlertTaskInfo(res.Info.ToPbType()), nil
 }
 
 type SignedTaskRespResponse struct {
@@ -185,17 +249,13 @@ func (h *JsonRpcHandler) ProcessSignedTaskResponse(
 	alertInfo AlertTaskInfo,
 	operatorRequestSignature hexutil.Bytes,
 	operatorId hexutil.Bytes,
+	auth *OperatorAuth,
 ) (SignedTaskRespResponse, error) {
 	req, err := message.NewSignedTaskRespRequest(&aggregator.SignedTaskRespRequest{
-		Alert: &aggregator.AlertTaskInfo{
-			AlertHash:                  alertInfo.AlertHash,
-			QuorumNumbers:              alertInfo.QuorumNumbers,
-			QuorumThresholdPercentages: alertInfo.QuorumThresholdPercentages,
-			TaskIndex:                  alertInfo.TaskIndex,
-			ReferenceBlockNumber:       alertInfo.ReferenceBlockNumber,
-		},
+		Alert:                    alertInfo.toPbType(),
 		OperatorRequestSignature: operatorRequestSignature,
 		OperatorId:               operatorId,
+		Auth:                     auth.toPbType(),
 	})
 	if err != nil {
 		return SignedTaskRespResponse{}, fmt.Errorf("processSignedTaskResponse parse request falied: %v", err)
@@ -203,7 +263,7 @@ func (h *JsonRpcHandler) ProcessSignedTaskResponse(
 
 	res, err := h.aggreagtor.ProcessSignedTaskResponse(req)
 	if err != nil {
-		return SignedTaskRespResponse{}, fmt.Errorf("processSignedTaskResponse process request falied: %v", err)
+		return SignedTaskRespResponse{}, wrapJsonRpcError("processSignedTaskResponse", err)
 	}
 
 	resp := SignedTaskRespResponse{
@@ -213,3 +273,225 @@ func (h *JsonRpcHandler) ProcessSignedTaskResponse(
 
 	return resp, nil
 }
+
+type TaskStatus struct {
+	// The task info
+	Info AlertTaskInfo `json:"info"`
+	// The state of task
+	State string `json:"state"`
+	// The reason if the task expired or failed
+	Reason string `json:"reason"`
+	// The count of the operators which signed the task
+	SignerCount uint32 `json:"signer_count"`
+	// The signed stake percentages for each quorum
+	SignedStakePercentages []uint8 `json:"signed_stake_percentages"`
+	// The tx hash of the confirm alert
+	TxHash hexutil.Bytes `json:"tx_hash"`
+	// The block number the confirm alert tx included
+	BlockNumber uint64 `json:"block_number"`
+	// The unix time of the last update
+	UpdatedAt int64 `json:"updated_at"`
+}
+
+func newTaskStatus(status *aggregator.TaskStatus) TaskStatus {
+	return TaskStatus{
+		Info:                   newAlertTaskInfo(status.Info),
+		State:                  status.State,
+		Reason:                 status.Reason,
+		SignerCount:            status.SignerCount,
+		SignedStakePercentages: status.SignedStakePercentages,
+		TxHash:                 status.TxHash,
+		BlockNumber:            status.BlockNumber,
+		UpdatedAt:              status.UpdatedAt,
+	}
+}
+
+// GetTaskStatus returns the status of the latest task for the alert hash in the rollup if it not empty,
+// else the task by the index, the rollupChainId is optional, the default rollup will be used if not set.
+func (h *JsonRpcHandler) GetTaskStatus(
+	ctx context.Context,
+	alertHash hexutil.Bytes,
+	taskIndex *uint32,
+	rollupChainId *uint32,
+) (TaskStatus, error) {
+	req := &aggregator.GetTaskStatusRequest{
+		AlertHash: alertHash,
+	}
+	if taskIndex != nil {
+		req.TaskIndex = *taskIndex
+	}
+	if rollupChainId != nil {
+		req.RollupChainId = *rollupChainId
+	}
+
+	msg, err := message.NewGetTaskStatusRequest(req)
+	if err != nil {
+		return TaskStatus{}, fmt.Errorf("getTaskStatus parse request falied: %v", err)
+	}
+
+	res, err := h.aggreagtor.GetTaskStatus(msg)
+	if err != nil {
+		return TaskStatus{}, wrapJsonRpcError("getTaskStatus", err)
+	}
+
+	return newTaskStatus(res.ToPbType()), nil
+}
+
+type ListTasksFilter struct {
+	// The state of tasks, empty for all
+	State string `json:"state"`
+	// The hash of alert, empty for all
+	AlertHash hexutil.Bytes `json:"alert_hash"`
+	// The rollup chain id, 0 for all
+	RollupChainId uint32 `json:"rollup_chain_id"`
+}
+
+type ListTasksPage struct {
+	// The task index to start
+	StartTaskIndex uint32 `json:"start_task_index"`
+	// The max count of tasks to return
+	Limit uint32 `json:"limit"`
+}
+
+type ListTasksResponse struct {
+	Tasks []TaskStatus `json:"tasks"`
+	// If there are more tasks after this page
+	HasMore bool `json:"has_more"`
+	// The start task index for the next page
+	NextTaskIndex uint32 `json:"next_task_index"`
+}
+
+// ListTasks returns the tasks status matched the filter, both filter and page are optional.
+func (h *JsonRpcHandler) ListTasks(
+	ctx context.Context,
+	filter *ListTasksFilter,
+	page *ListTasksPage,
+) (ListTasksResponse, error) {
+	req := &aggregator.ListTasksRequest{}
+	if filter != nil {
+		req.State = filter.State
+		req.AlertHash = filter.AlertHash
+		req.RollupChainId = filter.RollupChainId
+	}
+	if page != nil {
+		req.StartTaskIndex = page.StartTaskIndex
+		req.Limit = page.Limit
+	}
+
+	msg, err := message.NewListTasksRequest(req)
+	if err != nil {
+		return ListTasksResponse{}, fmt.Errorf("listTasks parse request falied: %v", err)
+	}
+
+	res, err := h.aggreagtor.ListTasks(msg)
+	if err != nil {
+		return ListTasksResponse{}, wrapJsonRpcError("listTasks", err)
+	}
+
+	pb := res.ToPbType()
+
+	resp := ListTasksResponse{
+		Tasks:         make([]TaskStatus, 0, len(pb.Tasks)),
+		HasMore:       pb.HasMore,
+		NextTaskIndex: pb.NextTaskIndex,
+	}
+	for _, status := range pb.Tasks {
+		resp.Tasks = append(resp.Tasks, newTaskStatus(status))
+	}
+
+	return resp, nil
+}
+
+type TaskEventsFilter struct {
+	// The hash of alert, empty for all
+	AlertHash hexutil.Bytes `json:"alert_hash"`
+	// The types of event, empty for all
+	Types []string `json:"types"`
+	// The rollup chain id, 0 for all
+	RollupChainId uint32 `json:"rollup_chain_id"`
+}
+
+type TaskEvent struct {
+	// The type of event
+	Type string `json:"type"`
+	// The task index
+	TaskIndex uint32 `json:"task_index"`
+	// The hash of alert
+	AlertHash hexutil.Bytes `json:"alert_hash"`
+	// The rollup chain id of alert
+	RollupChainId uint32 `json:"rollup_chain_id"`
+	// The operator id which signature accepted
+	OperatorId hexutil.Bytes `json:"operator_id,omitempty"`
+	// The tx hash of confirm alert
+	TxHash hexutil.Bytes `json:"tx_hash,omitempty"`
+	// The block number which the tx included
+	BlockNumber uint64 `json:"block_number,omitempty"`
+	// The reason for expired and failed
+	Reason string `json:"reason,omitempty"`
+	// The unix time of the event
+	Timestamp int64 `json:"timestamp"`
+}
+
+// TaskEvents creates a subscription for the task events by `aggregator_subscribe` with `taskEvents`,
+// the filter is optional, only supported by websocket.
+func (h *JsonRpcHandler) TaskEvents(
+	ctx context.Context,
+	filter *TaskEventsFilter,
+) (*gethrpc.Subscription, error) {
+	notifier, supported := gethrpc.NotifierFromContext(ctx)
+	if !supported {
+		return &gethrpc.Subscription{}, gethrpc.ErrNotificationsUnsupported
+	}
+
+	req := &aggregator.SubscribeTaskEventsRequest{}
+	if filter != nil {
+		req.AlertHash = filter.AlertHash
+		req.Types = filter.Types
+		req.RollupChainId = filter.RollupChainId
+	}
+
+	msg, err := message.NewSubscribeTaskEventsRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("subscribeTaskEvents parse request falied: %v", err)
+	}
+
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		events := make(chan *message.TaskEvent, taskEventsBufferSize)
+		sub := h.aggreagtor.SubscribeTaskEvents(events)
+		defer sub.Unsubscribe()
+
+		for {
+			select {
+			case <-rpcSub.Err():
+				return
+			case <-sub.Err():
+				return
+			case ev := <-events:
+				if !msg.Match(ev) {
+					continue
+				}
+
+				pb := ev.ToPbType()
+				err := notifier.Notify(rpcSub.ID, TaskEvent{
+					Type:          pb.Type,
+					TaskIndex:     pb.TaskIndex,
+					AlertHash:     pb.AlertHash,
+					RollupChainId: pb.RollupChainId,
+					OperatorId:    pb.OperatorId,
+					TxHash:        pb.TxHash,
+					BlockNumber:   pb.BlockNumber,
+					Reason:        pb.Reason,
+					Timestamp:     pb.Timestamp,
+				})
+				if err != nil {
+					h.logger.Warn("notify task event failed", "err", err)
+					return
+				}
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
@@ -106,3 +106,25 @@ func (agg *LegacyRpcHandler) ProcessSignedTaskResponse(req *message.SignedTaskRe
 	*reply = *res
 	return nil
 }
+
+// rpc endpoint to get the status of the latest task for the alert hash, or the task by index.
+func (agg *LegacyRpcHandler) GetTaskStatus(req *message.GetTaskStatusRequest, reply *message.TaskStatus) error {
+	res, err := agg.aggreagtor.GetTaskStatus(req)
+	if err != nil {
+		return err
+	}
+
+	*reply = *res
+	return nil
+}
+
+// rpc endpoint to list the tasks status matched the filter.
+func (agg *LegacyRpcHandler) ListTasks(req *message.ListTasksRequest, reply *message.ListTasksResponse) error {
+	res, err := agg.aggreagtor.ListTasks(req)
+	if err != nil {
+		return err
+	}
+
+	*reply = *res
+	return nil
+}
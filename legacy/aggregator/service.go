@@ -3,6 +3,7 @@ package aggregator
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -13,13 +14,18 @@ import (
 	"github.com/Layr-Labs/eigensdk-go/services/avsregistry"
 	blsagg "github.com/Layr-Labs/eigensdk-go/services/bls_aggregation"
 	"github.com/Layr-Labs/eigensdk-go/services/operatorsinfo"
+	sdktypes "github.com/Layr-Labs/eigensdk-go/types"
+	"github.com/ethereum/go-ethereum"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/alt-research/avs/legacy/aggregator/rpc"
+	"github.com/alt-research/avs/legacy/aggregator/store"
 	"github.com/alt-research/avs/legacy/aggregator/types"
 	"github.com/alt-research/avs/legacy/core/chainio"
 	"github.com/alt-research/avs/legacy/core/config"
 	"github.com/alt-research/avs/legacy/core/message"
-	"github.com/ethereum/go-ethereum/common"
+	"github.com/alt-research/avs/legacy/metrics"
 )
 
 type AggregatorService struct {
@@ -29,15 +35,37 @@ type AggregatorService struct {
 	avsReader chainio.AvsReaderer
 	ethClient eth.Client
 
+	// the rollups served by the aggregator, keyed by the rollup chain id
+	rollups map[uint32]*rollupChain
+
 	blsAggregationService blsagg.BlsAggregationService
-	tasks                 map[types.TaskIndex]*message.AlertTaskInfo
-	tasksMu               sync.RWMutex
-	finishedTasks         map[[32]byte]*FinishedTaskStatus
-	finishedTasksMu       sync.RWMutex
-	nextTaskIndex         types.TaskIndex
-	nextTaskIndexMu       sync.RWMutex
-	operatorStatus        map[common.Address]*OperatorStatus
-	operatorStatusMu      sync.RWMutex
+	authenticator         *OperatorAuthenticator
+	store                 store.TaskStore
+	blockWatcher          *BlockWatcher
+	metrics               metrics.AggregatorMetrics
+	metricsReg            *prometheus.Registry
+
+	// protect the task state transitions
+	taskStatusMu sync.Mutex
+
+	// the tasks which waiting for expired by block height
+	pendingTasks map[types.TaskIndex]*message.AlertTaskInfo
+	// the tasks which submitted before restart, the submitter will not track them,
+	// so they are failed if not confirmed by the indexer when expired, protected by pendingTasksMu
+	reloadedSubmittedTasks map[types.TaskIndex]*message.AlertTaskInfo
+	pendingTasksMu         sync.Mutex
+
+	// serialize the task creation for each alert, so the concurrent requests will not create duplicated tasks
+	alertLocks   map[alertLockKey]*alertLock
+	alertLocksMu sync.Mutex
+
+	// the operators which signed the task, to reject the duplicate signatures
+	taskSigners   map[types.TaskIndex]map[sdktypes.OperatorId]struct{}
+	taskSignersMu sync.Mutex
+
+	taskEventSubs   map[*taskEventSubscription]struct{}
+	taskEventSubsMu sync.Mutex
+	taskEvents      chan *message.TaskEvent
 }
 
 // NewAggregator creates a new Aggregator with the provided config.
@@ -48,13 +76,19 @@ func NewAggregatorService(c *config.Config) (*AggregatorService, error) {
 		return nil, err
 	}
 
+	rollups, err := newRollupChains(c)
+	if err != nil {
+		c.Logger.Error("Cannot create the rollups clients", "err", err)
+		return nil, err
+	}
+
 	chainioConfig := sdkclients.BuildAllConfig{
 		EthHttpUrl:                 c.EthHttpRpcUrl,
 		EthWsUrl:                   c.EthWsRpcUrl,
 		RegistryCoordinatorAddr:    c.RegistryCoordinatorAddr.String(),
 		OperatorStateRetrieverAddr: c.OperatorStateRetrieverAddr.String(),
 		AvsName:                    avsName,
-		PromMetricsIpPortAddress:   ":9090",
+		PromMetricsIpPortAddress:   c.EigenMetricsIpPortAddress,
 	}
 	clients, err := sdkclients.BuildAll(chainioConfig, c.PrivateKey, c.Logger)
 	if err != nil {
@@ -66,64 +100,334 @@ func NewAggregatorService(c *config.Config) (*AggregatorService, error) {
 	avsRegistryService := avsregistry.NewAvsRegistryServiceChainCaller(avsReader, operatorsinfoService, c.Logger)
 	blsAggregationService := blsagg.NewBlsAggregatorService(avsRegistryService, c.Logger)
 
-	return &AggregatorService{
-		logger:                c.Logger,
-		avsReader:             avsReader,
-		ethClient:             clients.EthHttpClient,
-		blsAggregationService: blsAggregationService,
-		tasks:                 make(map[types.TaskIndex]*message.AlertTaskInfo),
-		finishedTasks:         make(map[[32]byte]*FinishedTaskStatus),
-		operatorStatus:        make(map[common.Address]*OperatorStatus),
-		cfg:                   c,
-	}, nil
+	taskStore, err := store.NewTaskStore(c.Logger, c.TaskStorePath)
+	if err != nil {
+		c.Logger.Error("Cannot create task store", "err", err)
+		return nil, err
+	}
+
+	service := &AggregatorService{
+		logger:                 c.Logger,
+		avsReader:              avsReader,
+		rollups:                rollups,
+		ethClient:              clients.EthHttpClient,
+		blsAggregationService:  blsAggregationService,
+		authenticator:          NewOperatorAuthenticator(c.Logger, avsReader, operatorsinfoService),
+		store:                  taskStore,
+		metrics:                metrics.NewAggregatorAndEigenMetrics(clients.Metrics, clients.PrometheusRegistry),
+		metricsReg:             clients.PrometheusRegistry,
+		pendingTasks:           make(map[types.TaskIndex]*message.AlertTaskInfo),
+		reloadedSubmittedTasks: make(map[types.TaskIndex]*message.AlertTaskInfo),
+		taskSigners:            make(map[types.TaskIndex]map[sdktypes.OperatorId]struct{}),
+		alertLocks:             make(map[alertLockKey]*alertLock),
+		taskEventSubs:          make(map[*taskEventSubscription]struct{}),
+		taskEvents:             make(chan *message.TaskEvent, taskEventsQueueSize),
+		cfg:                    c,
+	}
+	service.blockWatcher = NewBlockWatcher(c.Logger, clients.EthWsClient, clients.EthHttpClient, service.onNewBlock)
+
+	return service, nil
 }
 
 var _ rpc.AggregatorRpcHandler = (*AggregatorService)(nil)
 
-func (agg *AggregatorService) GetTaskByAlertHash(alertHash [32]byte) *message.AlertTaskInfo {
-	agg.tasksMu.RLock()
-	defer agg.tasksMu.RUnlock()
+// errTaskExpiredBeforeInit is returned if the task had expired when initializing it, the task is set to expired.
+var errTaskExpiredBeforeInit = errors.New("the task had expired before initializing")
+
+func (agg *AggregatorService) GetTaskByAlertHash(rollupChainId uint32, alertHash [32]byte) (*message.AlertTaskInfo, error) {
+	return agg.store.GetTaskByAlertHash(rollupChainId, alertHash)
+}
+
+func (agg *AggregatorService) GetTaskByIndex(taskIndex types.TaskIndex) (*message.AlertTaskInfo, error) {
+	return agg.store.GetTaskByIndex(taskIndex)
+}
+
+func (agg *AggregatorService) GetFinishedTaskByAlertHash(rollupChainId uint32, alertHash [32]byte) (*store.FinishedTaskStatus, error) {
+	return agg.store.GetFinishedTaskByAlertHash(rollupChainId, alertHash)
+}
+
+func (agg *AggregatorService) SetFinishedTask(task *message.AlertTaskInfo, finished *store.FinishedTaskStatus) error {
+	return agg.store.PutFinishedTask(task.RollupChainId, task.AlertHash, finished)
+}
+
+// Start starts to watch the block height for the tasks expiry, then reloads the unfinished tasks.
+func (agg *AggregatorService) Start(ctx context.Context) error {
+	go agg.sendTaskEvents(ctx)
+
+	if err := agg.blockWatcher.Start(ctx); err != nil {
+		return fmt.Errorf("start block watcher failed: %w", err)
+	}
+
+	return agg.ReloadTasks()
+}
+
+// StartMetrics starts the metrics server if enabled, returns nil if the metrics not enabled.
+func (agg *AggregatorService) StartMetrics(ctx context.Context) <-chan error {
+	if !agg.cfg.EnableMetrics {
+		return nil
+	}
+
+	agg.logger.Info("Start metrics server", "address", agg.cfg.EigenMetricsIpPortAddress)
+	return agg.metrics.Start(ctx, agg.metricsReg)
+}
+
+// ReloadTasks reloads the unfinished tasks from the store and re-initializes them in the
+// blsAggregationService, the task which had expired will be skipped.
+//
+// The aggregated signatures and the submitter queue are not persisted, so the task which had reached
+// quorum is failed to let the operators create a new task for the alert, and the task which had submitted
+// is confirmed or failed by the receipt of its tx, it will not be aggregated again.
+func (agg *AggregatorService) ReloadTasks() error {
+	tasks, err := agg.store.GetUnfinishedTasks()
+	if err != nil {
+		return fmt.Errorf("get unfinished tasks failed: %w", err)
+	}
+
+	for _, task := range tasks {
+		// only the latest task for the alert will be used.
+		latest, err := agg.store.GetTaskByAlertHash(task.RollupChainId, task.AlertHash)
+		if err != nil {
+			return err
+		}
+		if latest == nil || latest.TaskIndex != task.TaskIndex {
+			continue
+		}
+
+		status, err := agg.store.GetTaskStatus(task.TaskIndex)
+		if err != nil {
+			return err
+		}
+		if status != nil && status.State.IsTerminal() {
+			continue
+		}
+
+		if _, ok := agg.rollups[task.RollupChainId]; !ok {
+			agg.logger.Warn("the rollup of task not served, skip reload", "taskIndex", task.TaskIndex, "rollup", task.RollupChainId)
+			if err := agg.SetTaskState(task, store.TaskStateFailed, "rollup not served by the aggregator"); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if status != nil && status.State == store.TaskStateSubmitted {
+			agg.logger.Info("reload submitted task", "taskIndex", task.TaskIndex, "alert", task.AlertHash, "txHash", status.TxHash)
+			if err := agg.reloadSubmittedTask(task, status); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if agg.IsTaskExpired(task) {
+			agg.logger.Info("the task had expired, skip reload", "taskIndex", task.TaskIndex, "alert", task.AlertHash)
+			if err := agg.SetTaskState(task, store.TaskStateExpired, "expired by block height"); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if status != nil && status.State == store.TaskStateQuorumReached {
+			agg.logger.Warn("the aggregated signature of task lost when restart", "taskIndex", task.TaskIndex, "alert", task.AlertHash)
+			if err := agg.SetTaskState(task, store.TaskStateFailed, "the aggregated signature lost when the aggregator restarted"); err != nil {
+				return err
+			}
+			continue
+		}
 
-	for _, task := range agg.tasks {
-		if task.AlertHash == alertHash {
-			return task
+		agg.logger.Info("reload task", "taskIndex", task.TaskIndex, "alert", task.AlertHash)
+		if err := agg.initializeTask(task); errors.Is(err, errTaskExpiredBeforeInit) {
+			agg.logger.Info("the task had expired, skip reload", "taskIndex", task.TaskIndex, "alert", task.AlertHash)
+			continue
+		} else if err != nil {
+			agg.logger.Error("InitializeNewTask for reload task failed", "taskIndex", task.TaskIndex, "err", err)
+			if err := agg.SetTaskState(task, store.TaskStateFailed, err.Error()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// the signatures had lost when restart, so the task need collect again
+		if status == nil || status.State == store.TaskStateCreated {
+			if err := agg.SetTaskState(task, store.TaskStateCollecting, ""); err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
 
-func (agg *AggregatorService) GetTaskByIndex(taskIndex types.TaskIndex) *message.AlertTaskInfo {
-	agg.tasksMu.RLock()
-	defer agg.tasksMu.RUnlock()
+// reloadSubmittedTask confirms or fails the task which submitted before restart by the receipt of its tx,
+// if the tx not mined yet, the task waits to be confirmed by the indexer until it expired.
+func (agg *AggregatorService) reloadSubmittedTask(task *message.AlertTaskInfo, status *store.TaskStatus) error {
+	receipt, err := agg.ethClient.TransactionReceipt(context.Background(), status.TxHash)
+	if err != nil && !errors.Is(err, ethereum.NotFound) {
+		agg.logger.Warn("get the receipt of submitted task failed", "taskIndex", task.TaskIndex, "txHash", status.TxHash, "err", err)
+	}
+
+	if err == nil && receipt != nil {
+		if receipt.BlockNumber != nil {
+			if err := agg.updateTaskStatus(task, func(status *store.TaskStatus) {
+				status.BlockNumber = receipt.BlockNumber.Uint64()
+			}); err != nil {
+				return err
+			}
+		}
 
-	res := agg.tasks[taskIndex]
+		if receipt.Status != gethtypes.ReceiptStatusSuccessful {
+			return agg.SetTaskStatus(task, store.TaskStateFailed, "confirm alert tx reverted", receipt.TxHash)
+		}
 
-	return res
+		err := agg.SetFinishedTask(task, &store.FinishedTaskStatus{
+			Message:          task,
+			TxHash:           receipt.TxHash,
+			BlockHash:        receipt.BlockHash,
+			BlockNumber:      receipt.BlockNumber,
+			TransactionIndex: receipt.TransactionIndex,
+		})
+		if err != nil {
+			return err
+		}
+
+		return agg.SetTaskStatus(task, store.TaskStateConfirmed, "", receipt.TxHash)
+	}
+
+	if agg.IsTaskExpired(task) {
+		return agg.SetTaskState(task, store.TaskStateFailed, "the tx submitted before restart not confirmed before expired")
+	}
+
+	agg.pendingTasksMu.Lock()
+	defer agg.pendingTasksMu.Unlock()
+
+	agg.reloadedSubmittedTasks[task.TaskIndex] = task
+
+	return nil
+}
+
+// TaskExpiredBlockNumber returns the block number at which the task will be expired.
+func (agg *AggregatorService) TaskExpiredBlockNumber(task *message.AlertTaskInfo) uint64 {
+	return task.ReferenceBlockNumber + agg.cfg.TaskChallengeWindowBlock
 }
 
-func (agg *AggregatorService) newIndex() types.TaskIndex {
-	agg.nextTaskIndexMu.Lock()
-	defer agg.nextTaskIndexMu.Unlock()
+// IsTaskExpired returns if the layer1 had reached the expired block number of the task.
+func (agg *AggregatorService) IsTaskExpired(task *message.AlertTaskInfo) bool {
+	return agg.blockWatcher.LatestBlockNumber() >= agg.TaskExpiredBlockNumber(task)
+}
 
-	res := agg.nextTaskIndex
-	agg.nextTaskIndex += 1
+// initializeTask initializes the task in the blsAggregationService, and waits the task expired by block height,
+// if the layer1 had reached the expired block number, the task is expired without initializing.
+func (agg *AggregatorService) initializeTask(task *message.AlertTaskInfo) error {
+	expiredBlockNumber := agg.TaskExpiredBlockNumber(task)
+	latestBlockNumber := agg.blockWatcher.LatestBlockNumber()
+	if latestBlockNumber >= expiredBlockNumber {
+		reason := fmt.Sprintf("expired by block height %d, reference block %d", latestBlockNumber, task.ReferenceBlockNumber)
+		if err := agg.SetTaskState(task, store.TaskStateExpired, reason); err != nil {
+			return err
+		}
+		return fmt.Errorf("%w: %s", errTaskExpiredBeforeInit, reason)
+	}
 
-	return res
+	// The blsAggregationService only accepts a duration, so we give it an upper bound by the max block time,
+	// the task will be expired by the block watcher when the layer1 reach the expired block number.
+	remainBlocks := expiredBlockNumber - latestBlockNumber
+	taskTimeToExpiry := time.Duration(remainBlocks) * maxBlockTimeDuration
+
+	agg.logger.Infof("InitializeNewTask %v %v", task.TaskIndex, taskTimeToExpiry)
+	err := agg.blsAggregationService.InitializeNewTask(
+		task.TaskIndex,
+		uint32(task.ReferenceBlockNumber),
+		task.QuorumNumbers,
+		task.QuorumThresholdPercentages,
+		taskTimeToExpiry,
+	)
+	if err != nil {
+		return err
+	}
+
+	agg.pendingTasksMu.Lock()
+	defer agg.pendingTasksMu.Unlock()
+
+	agg.pendingTasks[task.TaskIndex] = task
+
+	return nil
 }
 
-func (agg *AggregatorService) GetFinishedTaskByAlertHash(alertHash [32]byte) *FinishedTaskStatus {
-	agg.finishedTasksMu.RLock()
-	defer agg.finishedTasksMu.RUnlock()
+// onNewBlock is called by the block watcher, it will expire the tasks which reached the expired block number.
+func (agg *AggregatorService) onNewBlock(blockNumber uint64) {
+	agg.pendingTasksMu.Lock()
+	defer agg.pendingTasksMu.Unlock()
 
-	return agg.finishedTasks[alertHash]
+	for taskIndex, task := range agg.pendingTasks {
+		if blockNumber < agg.TaskExpiredBlockNumber(task) {
+			continue
+		}
+
+		delete(agg.pendingTasks, taskIndex)
+
+		status, err := agg.store.GetTaskStatus(taskIndex)
+		if err != nil {
+			agg.logger.Error("get task status failed", "taskIndex", taskIndex, "err", err)
+			continue
+		}
+
+		// the task which had submitted will be confirmed or failed by the tx.
+		if status != nil && !status.State.CanTransitionTo(store.TaskStateExpired) {
+			continue
+		}
+
+		reason := fmt.Sprintf("expired by block height %d, reference block %d", blockNumber, task.ReferenceBlockNumber)
+		if err := agg.SetTaskState(task, store.TaskStateExpired, reason); err != nil {
+			agg.logger.Error("set task expired failed", "taskIndex", taskIndex, "err", err)
+		}
+	}
+
+	for taskIndex, task := range agg.reloadedSubmittedTasks {
+		if blockNumber < agg.TaskExpiredBlockNumber(task) {
+			continue
+		}
+
+		delete(agg.reloadedSubmittedTasks, taskIndex)
+
+		status, err := agg.store.GetTaskStatus(taskIndex)
+		if err != nil {
+			agg.logger.Error("get task status failed", "taskIndex", taskIndex, "err", err)
+			continue
+		}
+
+		// the task had been confirmed by the indexer
+		if status == nil || status.State != store.TaskStateSubmitted {
+			continue
+		}
+
+		if err := agg.SetTaskState(task, store.TaskStateFailed, "the tx submitted before restart not confirmed before expired"); err != nil {
+			agg.logger.Error("set task failed", "taskIndex", taskIndex, "err", err)
+		}
+	}
 }
 
-func (agg *AggregatorService) SetFinishedTask(alertHash [32]byte, finished *FinishedTaskStatus) {
-	agg.finishedTasksMu.Lock()
-	defer agg.finishedTasksMu.Unlock()
+// Close closes the task store.
+func (agg *AggregatorService) Close() error {
+	return agg.store.Close()
+}
 
-	agg.finishedTasks[alertHash] = finished
+// authenticate checks the request is signed by a registered operator, if the operatorId is not nil,
+// the request should be signed by the operator.
+func (agg *AggregatorService) authenticate(method string, payload [32]byte, operatorId *sdktypes.OperatorId, auth *message.OperatorAuth) error {
+	if auth != nil && operatorId != nil && auth.OperatorId != *operatorId {
+		return message.NewAggregatorError(
+			message.ErrCodeUnauthorized,
+			"authenticate %s failed: the auth operator 0x%x not match the request 0x%x", method, auth.OperatorId, *operatorId,
+		)
+	}
+
+	if err := agg.authenticator.Authenticate(context.Background(), method, payload, auth); err != nil {
+		agg.logger.Warn("authenticate operator failed", "method", method, "err", err)
+		if _, ok := err.(*message.AggregatorError); ok {
+			return err
+		}
+		return fmt.Errorf("authenticate %s failed: %w", method, err)
+	}
+
+	return nil
 }
 
 // rpc endpoint which is called by operator
@@ -131,10 +435,25 @@ func (agg *AggregatorService) SetFinishedTask(alertHash [32]byte, finished *Fini
 func (agg *AggregatorService) InitOperator(req *message.InitOperatorRequest) (*message.InitOperatorResponse, error) {
 	agg.logger.Infof("Received InitOperator: %#v", req)
 
+	if err := agg.authenticate(message.AuthMethodInitOperator, req.AuthPayload(), &req.OperatorId, req.Auth); err != nil {
+		return nil, err
+	}
+
 	reply := &message.InitOperatorResponse{
 		Ok: false,
 	}
 
+	// the status is saved by the address, so it should be the one registered for the operator id
+	operatorAddress, err := agg.authenticator.GetOperatorAddress(context.Background(), req.OperatorId)
+	if err != nil {
+		return nil, err
+	}
+
+	if operatorAddress != req.OperatorAddress {
+		reply.Res = fmt.Sprintf("OperatorAddress invaild, expect %s", operatorAddress.Hex())
+		return reply, nil
+	}
+
 	if agg.cfg.OperatorStateRetrieverAddr != req.OperatorStateRetrieverAddr {
 		reply.Res = fmt.Sprintf("OperatorStateRetrieverAddr invaild, expect %s", agg.cfg.OperatorStateRetrieverAddr.Hex())
 		return reply, nil
@@ -150,17 +469,18 @@ func (agg *AggregatorService) InitOperator(req *message.InitOperatorRequest) (*m
 		return reply, nil
 	}
 
-	if agg.cfg.Layer2ChainId != req.ChainId {
-		reply.Res = fmt.Sprintf("Layer2ChainId invaild, expect %d", agg.cfg.Layer2ChainId)
+	if _, ok := agg.rollups[req.ChainId]; !ok {
+		reply.Res = fmt.Sprintf("Layer2ChainId invaild, expect one of %v", agg.rollupChainIds())
 		return reply, nil
 	}
 
-	agg.operatorStatusMu.Lock()
-	defer agg.operatorStatusMu.Unlock()
-
-	agg.operatorStatus[req.OperatorAddress] = &OperatorStatus{
+	err = agg.store.PutOperatorStatus(req.OperatorAddress, &store.OperatorStatus{
 		LastTime:   time.Now().Unix(),
 		OperatorId: req.OperatorId,
+	})
+	if err != nil {
+		agg.logger.Error("save operator status failed", "err", err)
+		return nil, err
 	}
 
 	reply.Ok = true
@@ -174,20 +494,59 @@ func (agg *AggregatorService) InitOperator(req *message.InitOperatorRequest) (*m
 // will try to init the task, if currently had a same task for the alert,
 // it will return the existing task.
 func (agg *AggregatorService) CreateTask(req *message.CreateTaskRequest) (*message.CreateTaskResponse, error) {
-	agg.logger.Info("Received CreateTask", "alertHash", req.AlertHash)
+	agg.logger.Info("Received CreateTask", "alertHash", req.AlertHash, "rollup", req.RollupChainId)
 
-	finished := agg.GetFinishedTaskByAlertHash(req.AlertHash)
+	if err := agg.authenticate(message.AuthMethodCreateTask, req.AuthPayload(), nil, req.Auth); err != nil {
+		return nil, err
+	}
+
+	rollup, err := agg.getRollup(req.RollupChainId)
+	if err != nil {
+		return nil, err
+	}
+	rollupChainId := rollup.cfg.ChainId
+
+	unlock := agg.lockAlert(rollupChainId, req.AlertHash)
+	defer unlock()
+
+	finished, err := agg.GetFinishedTaskByAlertHash(rollupChainId, req.AlertHash)
+	if err != nil {
+		return nil, err
+	}
 	if finished != nil {
-		return nil, fmt.Errorf("the task 0x%x already finished: 0x%x", req.AlertHash, finished.TxHash)
+		return nil, message.NewAggregatorError(message.ErrCodeTaskFinished, "the task 0x%x already finished: 0x%x", req.AlertHash, finished.TxHash)
+	}
+
+	task, err := agg.GetTaskByAlertHash(rollupChainId, req.AlertHash)
+	if err != nil {
+		return nil, err
+	}
+	if task != nil && agg.IsTaskExpired(task) {
+		agg.logger.Info("the task had expired, will create a new task", "alert", req.AlertHash, "expiredTask", task.TaskIndex)
+		task = nil
+	}
+
+	if task != nil {
+		status, err := agg.store.GetTaskStatus(task.TaskIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		if status != nil && (status.State == store.TaskStateExpired || status.State == store.TaskStateFailed) {
+			agg.logger.Info("the task had terminated, will create a new task", "alert", req.AlertHash, "state", status.State, "reason", status.Reason)
+			task = nil
+		}
 	}
 
-	task := agg.GetTaskByAlertHash(req.AlertHash)
 	if task == nil {
-		agg.logger.Info("create new task", "alert", req.AlertHash)
-		taskIndex := agg.newIndex()
+		agg.logger.Info("create new task", "alert", req.AlertHash, "rollup", rollupChainId)
+		taskIndex, err := agg.store.NewTaskIndex()
+		if err != nil {
+			agg.logger.Error("new task index failed", "err", err)
+			return nil, err
+		}
 
-		var err error
-		task, err = agg.sendNewTask(req.AlertHash, taskIndex)
+		task, err = agg.sendNewTask(rollup, req.AlertHash, taskIndex)
 
 		if err != nil {
 			agg.logger.Error("send new task failed", "err", err)
@@ -200,6 +559,44 @@ func (agg *AggregatorService) CreateTask(req *message.CreateTaskRequest) (*messa
 	return &message.CreateTaskResponse{Info: *task}, nil
 }
 
+type alertLockKey struct {
+	rollupChainId uint32
+	alertHash     [32]byte
+}
+
+type alertLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// lockAlert locks the alert in the rollup, returns the func to unlock it,
+// the lock is removed when no one holds or waits it.
+func (agg *AggregatorService) lockAlert(rollupChainId uint32, alertHash [32]byte) func() {
+	key := alertLockKey{rollupChainId: rollupChainId, alertHash: alertHash}
+
+	agg.alertLocksMu.Lock()
+	lock, ok := agg.alertLocks[key]
+	if !ok {
+		lock = &alertLock{}
+		agg.alertLocks[key] = lock
+	}
+	lock.refs += 1
+	agg.alertLocksMu.Unlock()
+
+	lock.mu.Lock()
+
+	return func() {
+		lock.mu.Unlock()
+
+		agg.alertLocksMu.Lock()
+		lock.refs -= 1
+		if lock.refs == 0 {
+			delete(agg.alertLocks, key)
+		}
+		agg.alertLocksMu.Unlock()
+	}
+}
+
 // rpc endpoint which is called by operator
 // reply doesn't need to be checked. If there are no errors, the task response is accepted
 // rpc framework forces a reply type to exist, so we put bool as a placeholder
@@ -210,15 +607,59 @@ func (agg *AggregatorService) ProcessSignedTaskResponse(signedTaskResponse *mess
 		"operatorId", hex.EncodeToString(signedTaskResponse.OperatorId[:]),
 	)
 
-	taskIndex := signedTaskResponse.Alert.TaskIndex
-	taskResponseDigest, err := signedTaskResponse.Alert.SignHash()
+	authPayload, err := signedTaskResponse.AuthPayload()
+	if err != nil {
+		return nil, err
+	}
+	err = agg.authenticate(message.AuthMethodProcessSignedTaskResponse, authPayload, &signedTaskResponse.OperatorId, signedTaskResponse.Auth)
 	if err != nil {
 		return nil, err
 	}
 
-	if task := agg.GetTaskByIndex(taskIndex); task == nil {
+	taskIndex := signedTaskResponse.Alert.TaskIndex
+	task, err := agg.GetTaskByIndex(taskIndex)
+	if err != nil {
+		return nil, err
+	}
+	if task == nil {
 		agg.logger.Error("ProcessNewSignature error by no task exist", "taskIndex", taskIndex)
-		return nil, fmt.Errorf("task not found")
+		return nil, message.NewAggregatorError(message.ErrCodeTaskNotFound, "task %d not found", taskIndex)
+	}
+
+	status, err := agg.store.GetTaskStatus(taskIndex)
+	if err != nil {
+		return nil, err
+	}
+	if status != nil && status.State.IsTerminal() {
+		agg.logger.Error("ProcessNewSignature error by task terminated", "taskIndex", taskIndex, "state", status.State)
+		if status.Reason != "" {
+			return nil, message.NewAggregatorError(message.ErrCodeTaskTerminated, "task %d %s: %s", taskIndex, status.State, status.Reason)
+		}
+		return nil, message.NewAggregatorError(message.ErrCodeTaskTerminated, "task %d %s", taskIndex, status.State)
+	}
+
+	if agg.IsTaskExpired(task) {
+		agg.logger.Error("ProcessNewSignature error by task expired", "taskIndex", taskIndex)
+		return nil, message.NewAggregatorError(message.ErrCodeTaskTerminated, "task %d expired", taskIndex)
+	}
+
+	if err := agg.validateSignedTaskResponse(context.Background(), task, signedTaskResponse); err != nil {
+		agg.logger.Error("ProcessNewSignature error by invalid signature", "taskIndex", taskIndex, "err", err)
+		return nil, err
+	}
+
+	if !agg.addTaskSigner(taskIndex, signedTaskResponse.OperatorId) {
+		return nil, message.NewAggregatorError(
+			message.ErrCodeDuplicateSignature,
+			"operator 0x%x had signed the task %d", signedTaskResponse.OperatorId, taskIndex,
+		)
+	}
+
+	// the digest is from the stored task, which had been checked same as the signed alert.
+	taskResponseDigest, err := task.SignHash()
+	if err != nil {
+		agg.removeTaskSigner(taskIndex, signedTaskResponse.OperatorId)
+		return nil, err
 	}
 
 	agg.logger.Infof("ProcessNewSignature: %#v", signedTaskResponse.Alert.TaskIndex)
@@ -229,9 +670,123 @@ func (agg *AggregatorService) ProcessSignedTaskResponse(signedTaskResponse *mess
 
 	if err != nil {
 		agg.logger.Error("ProcessNewSignature error", "err", err)
+		agg.removeTaskSigner(taskIndex, signedTaskResponse.OperatorId)
+		return nil, err
+	}
+
+	err = agg.updateTaskStatus(task, func(status *store.TaskStatus) {
+		status.SignerCount += 1
+	})
+	if err != nil {
+		agg.logger.Error("save the signer count failed", "taskIndex", taskIndex, "err", err)
 	}
 
-	return &message.SignedTaskRespResponse{}, err
+	agg.publishTaskEvent(&message.TaskEvent{
+		Type:          message.TaskEventSignatureAccepted,
+		TaskIndex:     taskIndex,
+		AlertHash:     task.AlertHash,
+		RollupChainId: task.RollupChainId,
+		OperatorId:    signedTaskResponse.OperatorId,
+	})
+
+	return &message.SignedTaskRespResponse{}, nil
+}
+
+// rpc endpoint which is called by operator or the monitor
+// will return the status of the latest task for the alert hash, or the task by index.
+func (agg *AggregatorService) GetTaskStatus(req *message.GetTaskStatusRequest) (*message.TaskStatus, error) {
+	var (
+		task *message.AlertTaskInfo
+		err  error
+	)
+	if req.AlertHash != nil {
+		var rollup *rollupChain
+		if rollup, err = agg.getRollup(req.RollupChainId); err != nil {
+			return nil, err
+		}
+		task, err = agg.GetTaskByAlertHash(rollup.cfg.ChainId, *req.AlertHash)
+	} else {
+		task, err = agg.GetTaskByIndex(req.TaskIndex)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if task == nil {
+		if req.AlertHash != nil {
+			return nil, message.NewAggregatorError(message.ErrCodeTaskNotFound, "task for alert 0x%x not found", *req.AlertHash)
+		}
+		return nil, message.NewAggregatorError(message.ErrCodeTaskNotFound, "task %d not found", req.TaskIndex)
+	}
+
+	status, err := agg.store.GetTaskStatus(task.TaskIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTaskStatusMessage(task, status), nil
+}
+
+// rpc endpoint which is called by operator or the monitor
+// will return the tasks status matched the filter from the start task index.
+func (agg *AggregatorService) ListTasks(req *message.ListTasksRequest) (*message.ListTasksResponse, error) {
+	filter := store.TaskFilter{
+		State:         store.TaskState(req.State),
+		AlertHash:     req.AlertHash,
+		RollupChainId: req.RollupChainId,
+	}
+	if filter.State != "" && !filter.State.IsValid() {
+		return nil, fmt.Errorf("invalid task state %s", req.State)
+	}
+
+	limit := req.GetLimit()
+
+	// fetch one more to know if there are more tasks
+	statusList, err := agg.store.ListTaskStatus(filter, req.StartTaskIndex, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &message.ListTasksResponse{
+		Tasks: make([]message.TaskStatus, 0, len(statusList)),
+	}
+	if len(statusList) > limit {
+		res.HasMore = true
+		res.NextTaskIndex = statusList[limit].TaskIndex
+		statusList = statusList[:limit]
+	}
+
+	for _, status := range statusList {
+		task, err := agg.GetTaskByIndex(status.TaskIndex)
+		if err != nil {
+			return nil, err
+		}
+		if task == nil {
+			agg.logger.Warn("the task for status not found", "taskIndex", status.TaskIndex)
+			continue
+		}
+
+		res.Tasks = append(res.Tasks, *newTaskStatusMessage(task, status))
+	}
+
+	return res, nil
+}
+
+func newTaskStatusMessage(task *message.AlertTaskInfo, status *store.TaskStatus) *message.TaskStatus {
+	res := &message.TaskStatus{
+		Info: *task,
+	}
+
+	if status != nil {
+		res.State = string(status.State)
+		res.Reason = status.Reason
+		res.SignerCount = status.SignerCount
+		res.SignedStakePercentages = status.SignedStakePercentages
+		res.TxHash = status.TxHash
+		res.BlockNumber = status.BlockNumber
+		res.UpdatedAt = status.UpdatedAt
+	}
+
+	return res
 }
 
 // GetResponseChannel returns the single channel that meant to be used as the response channel
@@ -243,8 +798,8 @@ func (agg *AggregatorService) GetResponseChannel() <-chan blsagg.BlsAggregationS
 
 // sendNewTask sends a new task to the task manager contract, and updates the Task dict struct
 // with the information of operators opted into quorum 0 at the block of task creation.
-func (agg *AggregatorService) sendNewTask(alertHash message.Bytes32, taskIndex types.TaskIndex) (*message.AlertTaskInfo, error) {
-	agg.logger.Info("Aggregator sending new task", "alert", alertHash, "task", taskIndex)
+func (agg *AggregatorService) sendNewTask(rollup *rollupChain, alertHash message.Bytes32, taskIndex types.TaskIndex) (*message.AlertTaskInfo, error) {
+	agg.logger.Info("Aggregator sending new task", "alert", alertHash, "task", taskIndex, "rollup", rollup.cfg.ChainId)
 
 	var err error
 
@@ -258,22 +813,22 @@ func (agg *AggregatorService) sendNewTask(alertHash message.Bytes32, taskIndex t
 
 	agg.logger.Info("get from layer1", "referenceBlockNumber", referenceBlockNumber)
 
-	quorumNumbers, err := agg.avsReader.GetQuorumsByBlockNumber(context.Background(), uint32(referenceBlockNumber))
+	quorumNumbers, err := rollup.avsReader.GetQuorumsByBlockNumber(context.Background(), uint32(referenceBlockNumber))
 	if err != nil {
 		agg.logger.Error("GetQuorumCountByBlockNumber failed", "err", err)
 		return nil, err
 	}
 	agg.logger.Info("get quorumNumbers from layer1", "quorumNumbers", fmt.Sprintf("%v", quorumNumbers))
 
-	if len(quorumNumbers) < len(agg.cfg.QuorumNums) {
+	if len(quorumNumbers) < len(rollup.cfg.QuorumNums) {
 		agg.logger.Error("the cfg quorum numbers is larger to the layer1, it will commit failed")
-		return nil, fmt.Errorf("the quorum numbers is larger to the layer1 %v, expected %v", agg.cfg.QuorumNums, quorumNumbers)
+		return nil, fmt.Errorf("the quorum numbers is larger to the layer1 %v, expected %v", rollup.cfg.QuorumNums, quorumNumbers)
 	}
 
 	// just use config value
-	quorumNumbers = agg.cfg.QuorumNums
+	quorumNumbers = rollup.cfg.QuorumNums
 
-	quorumThresholdPercentages, err := agg.avsReader.GetQuorumThresholdPercentages(context.Background(), uint32(referenceBlockNumber), quorumNumbers)
+	quorumThresholdPercentages, err := rollup.avsReader.GetQuorumThresholdPercentages(context.Background(), uint32(referenceBlockNumber), quorumNumbers)
 	if err != nil {
 		agg.logger.Error("GetQuorumThresholdPercentages failed", "err", err)
 		return nil, err
@@ -291,26 +846,29 @@ func (agg *AggregatorService) sendNewTask(alertHash message.Bytes32, taskIndex t
 		QuorumThresholdPercentages: quorumThresholdPercentages,
 		TaskIndex:                  taskIndex,
 		ReferenceBlockNumber:       referenceBlockNumber,
+		RollupChainId:              rollup.cfg.ChainId,
 	}
 
-	agg.tasksMu.Lock()
-	agg.tasks[taskIndex] = newAlertTask
-	agg.tasksMu.Unlock()
+	if err := agg.store.PutTask(newAlertTask); err != nil {
+		agg.logger.Error("save task failed", "err", err)
+		return nil, err
+	}
 
-	// TODO(samlaf): we use seconds for now, but we should ideally pass a blocknumber to the blsAggregationService
-	// and it should monitor the chain and only expire the task aggregation once the chain has reached that block number.
-	taskTimeToExpiry := taskChallengeWindowBlock * blockTimeDuration
+	if err := agg.SetTaskState(newAlertTask, store.TaskStateCreated, ""); err != nil {
+		return nil, err
+	}
 
-	agg.logger.Infof("InitializeNewTask %v %v", taskIndex, taskTimeToExpiry)
-	err = agg.blsAggregationService.InitializeNewTask(
-		taskIndex,
-		uint32(newAlertTask.ReferenceBlockNumber),
-		newAlertTask.QuorumNumbers,
-		newAlertTask.QuorumThresholdPercentages,
-		taskTimeToExpiry,
-	)
-	if err != nil {
+	if err := agg.initializeTask(newAlertTask); errors.Is(err, errTaskExpiredBeforeInit) {
+		return nil, message.NewAggregatorError(message.ErrCodeTaskTerminated, "task %d %v", newAlertTask.TaskIndex, err)
+	} else if err != nil {
 		agg.logger.Error("InitializeNewTask failed", "err", err)
+		if err := agg.SetTaskState(newAlertTask, store.TaskStateFailed, err.Error()); err != nil {
+			agg.logger.Error("set task failed state failed", "err", err)
+		}
+		return nil, err
+	}
+
+	if err := agg.SetTaskState(newAlertTask, store.TaskStateCollecting, ""); err != nil {
 		return nil, err
 	}
 	return newAlertTask, nil
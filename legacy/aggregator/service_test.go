@@ -0,0 +1,383 @@
+package aggregator
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/clients/eth"
+	"github.com/Layr-Labs/eigensdk-go/crypto/bls"
+	sdklogging "github.com/Layr-Labs/eigensdk-go/logging"
+	blsaggmocks "github.com/Layr-Labs/eigensdk-go/services/mocks/blsagg"
+	sdktypes "github.com/Layr-Labs/eigensdk-go/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alt-research/avs/legacy/aggregator/store"
+	"github.com/alt-research/avs/legacy/aggregator/types"
+	"github.com/alt-research/avs/legacy/core/chainio/mocks"
+	"github.com/alt-research/avs/legacy/core/config"
+	"github.com/alt-research/avs/legacy/core/message"
+	"github.com/alt-research/avs/legacy/metrics"
+)
+
+// fakeOperatorsInfo returns the pubkeys of the operators by the address.
+type fakeOperatorsInfo map[common.Address]sdktypes.OperatorInfo
+
+func (f fakeOperatorsInfo) GetOperatorInfo(ctx context.Context, operator common.Address) (sdktypes.OperatorInfo, bool) {
+	info, ok := f[operator]
+	return info, ok
+}
+
+func TestInitOperatorAddress(t *testing.T) {
+	keypair, err := bls.GenRandomBlsKeys()
+	if err != nil {
+		t.Fatalf("generate the bls keys failed: %v", err)
+	}
+
+	operatorId := sdktypes.OperatorId{1}
+	operatorAddress := common.HexToAddress("0x01")
+	otherAddress := common.HexToAddress("0x02")
+
+	cfg := &config.Config{
+		Layer1ChainId:              1,
+		OperatorStateRetrieverAddr: common.HexToAddress("0x10"),
+		RegistryCoordinatorAddr:    common.HexToAddress("0x11"),
+	}
+
+	cases := []struct {
+		name    string
+		address common.Address
+		ok      bool
+	}{
+		{
+			name:    "registered address",
+			address: operatorAddress,
+			ok:      true,
+		},
+		{
+			name:    "address of another operator",
+			address: otherAddress,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			avsReader := mocks.NewMockAvsReaderer(ctrl)
+			avsReader.EXPECT().GetOperatorFromId(gomock.Any(), operatorId).Return(operatorAddress, nil).AnyTimes()
+			avsReader.EXPECT().IsOperatorRegistered(gomock.Any(), operatorAddress).Return(true, nil).AnyTimes()
+
+			operatorsInfo := fakeOperatorsInfo{
+				operatorAddress: {Pubkeys: sdktypes.OperatorPubkeys{G1Pubkey: keypair.GetPubKeyG1(), G2Pubkey: keypair.GetPubKeyG2()}},
+			}
+
+			taskStore := store.NewMemoryTaskStore()
+			agg := &AggregatorService{
+				logger:        sdklogging.NewNoopLogger(),
+				cfg:           cfg,
+				authenticator: NewOperatorAuthenticator(sdklogging.NewNoopLogger(), avsReader, operatorsInfo),
+				store:         taskStore,
+				rollups: map[uint32]*rollupChain{
+					42: {cfg: &config.RollupConfig{ChainId: 42}},
+				},
+			}
+
+			req := &message.InitOperatorRequest{
+				Layer1ChainId:              cfg.Layer1ChainId,
+				ChainId:                    42,
+				OperatorId:                 operatorId,
+				OperatorAddress:            c.address,
+				OperatorStateRetrieverAddr: cfg.OperatorStateRetrieverAddr,
+				RegistryCoordinatorAddr:    cfg.RegistryCoordinatorAddr,
+			}
+			req.Auth = message.NewOperatorAuth(keypair, operatorId, message.AuthMethodInitOperator, req.AuthPayload())
+
+			reply, err := agg.InitOperator(req)
+			if err != nil {
+				t.Fatalf("init operator failed: %v", err)
+			}
+
+			if reply.Ok != c.ok {
+				t.Fatalf("expect ok %v, got %v: %s", c.ok, reply.Ok, reply.Res)
+			}
+
+			status, err := taskStore.GetOperatorStatus(c.address)
+			if err != nil {
+				t.Fatalf("get the operator status failed: %v", err)
+			}
+			if (status != nil) != c.ok {
+				t.Fatalf("expect the status saved %v, got %+v", c.ok, status)
+			}
+		})
+	}
+}
+
+func TestInitializeExpiredTask(t *testing.T) {
+	taskStore := store.NewMemoryTaskStore()
+	agg := &AggregatorService{
+		logger:       sdklogging.NewNoopLogger(),
+		cfg:          &config.Config{TaskChallengeWindowBlock: 100},
+		store:        taskStore,
+		metrics:      metrics.NewNoopMetrics(),
+		blockWatcher: &BlockWatcher{},
+		pendingTasks: make(map[types.TaskIndex]*message.AlertTaskInfo),
+	}
+
+	task := &message.AlertTaskInfo{TaskIndex: 1, ReferenceBlockNumber: 100, RollupChainId: 42}
+	if err := agg.SetTaskState(task, store.TaskStateCreated, ""); err != nil {
+		t.Fatalf("set the task created failed: %v", err)
+	}
+
+	// the head had passed the expired block 200 when reloading the task after a downtime
+	agg.blockWatcher.latestBlockNumber.Store(250)
+
+	// the blsAggregationService is nil, so it will panic if the task initialized
+	err := agg.initializeTask(task)
+	if !errors.Is(err, errTaskExpiredBeforeInit) {
+		t.Fatalf("expect the task expired, got %v", err)
+	}
+
+	status, err := taskStore.GetTaskStatus(task.TaskIndex)
+	if err != nil {
+		t.Fatalf("get the task status failed: %v", err)
+	}
+	if status.State != store.TaskStateExpired {
+		t.Fatalf("expect the task expired, got %s", status.State)
+	}
+
+	if len(agg.pendingTasks) != 0 {
+		t.Fatalf("the expired task should not wait for expiring")
+	}
+}
+
+// fakeReceiptsClient returns the receipts by the tx hash, the tx not in it is not found.
+type fakeReceiptsClient struct {
+	eth.Client
+	receipts map[common.Hash]*gethtypes.Receipt
+}
+
+func (c *fakeReceiptsClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*gethtypes.Receipt, error) {
+	receipt, ok := c.receipts[txHash]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+
+	return receipt, nil
+}
+
+func TestReloadTasks(t *testing.T) {
+	const rollupChainId uint32 = 42
+
+	var (
+		minedTxHash    = common.HexToHash("0x01")
+		revertedTxHash = common.HexToHash("0x02")
+		pendingTxHash  = common.HexToHash("0x03")
+	)
+
+	// the tasks are expired at the block 200 except the one with the reference block 40
+	tasks := []struct {
+		task   *message.AlertTaskInfo
+		status *store.TaskStatus
+		// the state after reloaded
+		state store.TaskState
+	}{
+		{
+			task:   &message.AlertTaskInfo{TaskIndex: 1, AlertHash: [32]byte{1}, ReferenceBlockNumber: 100},
+			status: &store.TaskStatus{State: store.TaskStateCollecting},
+			state:  store.TaskStateCollecting,
+		},
+		{
+			task:   &message.AlertTaskInfo{TaskIndex: 2, AlertHash: [32]byte{2}, ReferenceBlockNumber: 100},
+			status: &store.TaskStatus{State: store.TaskStateQuorumReached},
+			state:  store.TaskStateFailed,
+		},
+		{
+			task:   &message.AlertTaskInfo{TaskIndex: 3, AlertHash: [32]byte{3}, ReferenceBlockNumber: 40},
+			status: &store.TaskStatus{State: store.TaskStateQuorumReached},
+			state:  store.TaskStateExpired,
+		},
+		{
+			task:   &message.AlertTaskInfo{TaskIndex: 4, AlertHash: [32]byte{4}, ReferenceBlockNumber: 100},
+			status: &store.TaskStatus{State: store.TaskStateSubmitted, TxHash: minedTxHash},
+			state:  store.TaskStateConfirmed,
+		},
+		{
+			task:   &message.AlertTaskInfo{TaskIndex: 5, AlertHash: [32]byte{5}, ReferenceBlockNumber: 100},
+			status: &store.TaskStatus{State: store.TaskStateSubmitted, TxHash: revertedTxHash},
+			state:  store.TaskStateFailed,
+		},
+		{
+			task:   &message.AlertTaskInfo{TaskIndex: 6, AlertHash: [32]byte{6}, ReferenceBlockNumber: 100},
+			status: &store.TaskStatus{State: store.TaskStateSubmitted, TxHash: pendingTxHash},
+			state:  store.TaskStateSubmitted,
+		},
+		{
+			// the tx not mined before the task expired
+			task:   &message.AlertTaskInfo{TaskIndex: 7, AlertHash: [32]byte{7}, ReferenceBlockNumber: 40},
+			status: &store.TaskStatus{State: store.TaskStateSubmitted, TxHash: pendingTxHash},
+			state:  store.TaskStateFailed,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+
+	// only the collecting task will be aggregated again
+	blsAggregationService := blsaggmocks.NewMockBlsAggregationService(ctrl)
+	blsAggregationService.EXPECT().
+		InitializeNewTask(types.TaskIndex(1), uint32(100), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	taskStore := store.NewMemoryTaskStore()
+	agg := &AggregatorService{
+		logger: sdklogging.NewNoopLogger(),
+		cfg:    &config.Config{TaskChallengeWindowBlock: 100},
+		ethClient: &fakeReceiptsClient{receipts: map[common.Hash]*gethtypes.Receipt{
+			minedTxHash:    {Status: gethtypes.ReceiptStatusSuccessful, TxHash: minedTxHash, BlockNumber: big.NewInt(160)},
+			revertedTxHash: {Status: gethtypes.ReceiptStatusFailed, TxHash: revertedTxHash, BlockNumber: big.NewInt(161)},
+		}},
+		rollups:                map[uint32]*rollupChain{rollupChainId: {}},
+		blsAggregationService:  blsAggregationService,
+		store:                  taskStore,
+		metrics:                metrics.NewNoopMetrics(),
+		blockWatcher:           &BlockWatcher{},
+		pendingTasks:           make(map[types.TaskIndex]*message.AlertTaskInfo),
+		reloadedSubmittedTasks: make(map[types.TaskIndex]*message.AlertTaskInfo),
+	}
+	agg.blockWatcher.latestBlockNumber.Store(150)
+
+	for _, c := range tasks {
+		c.task.RollupChainId = rollupChainId
+		c.status.TaskIndex = c.task.TaskIndex
+		c.status.AlertHash = c.task.AlertHash
+		c.status.RollupChainId = rollupChainId
+
+		if err := taskStore.PutTask(c.task); err != nil {
+			t.Fatalf("put the task %d failed: %v", c.task.TaskIndex, err)
+		}
+		if err := taskStore.PutTaskStatus(c.status); err != nil {
+			t.Fatalf("put the task status %d failed: %v", c.task.TaskIndex, err)
+		}
+	}
+
+	if err := agg.ReloadTasks(); err != nil {
+		t.Fatalf("reload the tasks failed: %v", err)
+	}
+
+	for _, c := range tasks {
+		status, err := taskStore.GetTaskStatus(c.task.TaskIndex)
+		if err != nil {
+			t.Fatalf("get the task status %d failed: %v", c.task.TaskIndex, err)
+		}
+		if status.State != c.state {
+			t.Fatalf("expect the task %d %s after reloaded, got %s: %s", c.task.TaskIndex, c.state, status.State, status.Reason)
+		}
+	}
+
+	finished, err := taskStore.GetFinishedTaskByAlertHash(rollupChainId, [32]byte{4})
+	if err != nil {
+		t.Fatalf("get the finished task failed: %v", err)
+	}
+	if finished == nil || finished.TxHash != minedTxHash {
+		t.Fatalf("the task confirmed by the mined tx should be finished, got %+v", finished)
+	}
+
+	status, err := taskStore.GetTaskStatus(5)
+	if err != nil {
+		t.Fatalf("get the task status failed: %v", err)
+	}
+	if status.BlockNumber != 161 {
+		t.Fatalf("expect the reverted tx at block 161, got %d", status.BlockNumber)
+	}
+
+	if _, ok := agg.reloadedSubmittedTasks[6]; !ok || len(agg.reloadedSubmittedTasks) != 1 {
+		t.Fatalf("only the task with the pending tx should wait for expiring, got %v", agg.reloadedSubmittedTasks)
+	}
+
+	// the pending tx is not confirmed by the indexer before the task expired
+	agg.onNewBlock(199)
+	status, err = taskStore.GetTaskStatus(6)
+	if err != nil {
+		t.Fatalf("get the task status failed: %v", err)
+	}
+	if status.State != store.TaskStateSubmitted {
+		t.Fatalf("the task should be submitted before expired, got %s", status.State)
+	}
+
+	agg.onNewBlock(200)
+	status, err = taskStore.GetTaskStatus(6)
+	if err != nil {
+		t.Fatalf("get the task status failed: %v", err)
+	}
+	if status.State != store.TaskStateFailed {
+		t.Fatalf("the task should be failed when expired, got %s", status.State)
+	}
+	if len(agg.reloadedSubmittedTasks) != 0 {
+		t.Fatalf("the expired task should not be waiting, got %v", agg.reloadedSubmittedTasks)
+	}
+}
+
+func TestLockAlert(t *testing.T) {
+	agg := &AggregatorService{alertLocks: make(map[alertLockKey]*alertLock)}
+
+	const workers = 16
+
+	var (
+		wg      sync.WaitGroup
+		holders atomic.Int32
+		count   int
+	)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			unlock := agg.lockAlert(42, [32]byte{1})
+			defer unlock()
+
+			if holders.Add(1) != 1 {
+				t.Errorf("the alert is locked by more than one holder")
+			}
+			// the counter is protected by the lock
+			count += 1
+			time.Sleep(time.Millisecond)
+			holders.Add(-1)
+		}()
+	}
+
+	// the same alert in another rollup and another alert are not blocked
+	unlock := agg.lockAlert(42, [32]byte{1})
+	for _, key := range []alertLockKey{{rollupChainId: 43, alertHash: [32]byte{1}}, {rollupChainId: 42, alertHash: [32]byte{2}}} {
+		locked := make(chan struct{})
+		go func(key alertLockKey) {
+			agg.lockAlert(key.rollupChainId, key.alertHash)()
+			close(locked)
+		}(key)
+
+		select {
+		case <-locked:
+		case <-time.After(time.Second):
+			t.Fatalf("the alert %x in rollup %d is blocked by another alert", key.alertHash, key.rollupChainId)
+		}
+	}
+	unlock()
+
+	wg.Wait()
+
+	if count != workers {
+		t.Fatalf("expect %d holders, got %d", workers, count)
+	}
+
+	agg.alertLocksMu.Lock()
+	defer agg.alertLocksMu.Unlock()
+	if len(agg.alertLocks) != 0 {
+		t.Fatalf("the locks should be removed after unlocked, got %d", len(agg.alertLocks))
+	}
+}
@@ -0,0 +1,190 @@
+package store
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/alt-research/avs/legacy/aggregator/types"
+	"github.com/alt-research/avs/legacy/core/message"
+)
+
+// MemoryTaskStore keeps all the datas in memory, will lost all when exit.
+type MemoryTaskStore struct {
+	tasks            map[types.TaskIndex]*message.AlertTaskInfo
+	tasksMu          sync.RWMutex
+	taskStatus       map[types.TaskIndex]*TaskStatus
+	taskStatusMu     sync.RWMutex
+	finishedTasks    map[alertKey]*FinishedTaskStatus
+	finishedTasksMu  sync.RWMutex
+	nextTaskIndex    types.TaskIndex
+	nextTaskIndexMu  sync.Mutex
+	operatorStatus   map[common.Address]*OperatorStatus
+	operatorStatusMu sync.RWMutex
+}
+
+var _ TaskStore = (*MemoryTaskStore)(nil)
+
+// the alert is identified by the rollup chain id and the alert hash
+type alertKey struct {
+	rollupChainId uint32
+	alertHash     [32]byte
+}
+
+func NewMemoryTaskStore() *MemoryTaskStore {
+	return &MemoryTaskStore{
+		tasks:          make(map[types.TaskIndex]*message.AlertTaskInfo),
+		taskStatus:     make(map[types.TaskIndex]*TaskStatus),
+		finishedTasks:  make(map[alertKey]*FinishedTaskStatus),
+		operatorStatus: make(map[common.Address]*OperatorStatus),
+	}
+}
+
+func (s *MemoryTaskStore) NewTaskIndex() (types.TaskIndex, error) {
+	s.nextTaskIndexMu.Lock()
+	defer s.nextTaskIndexMu.Unlock()
+
+	res := s.nextTaskIndex
+	s.nextTaskIndex += 1
+
+	return res, nil
+}
+
+func (s *MemoryTaskStore) PutTask(task *message.AlertTaskInfo) error {
+	s.tasksMu.Lock()
+	defer s.tasksMu.Unlock()
+
+	s.tasks[task.TaskIndex] = task
+
+	return nil
+}
+
+func (s *MemoryTaskStore) GetTaskByIndex(taskIndex types.TaskIndex) (*message.AlertTaskInfo, error) {
+	s.tasksMu.RLock()
+	defer s.tasksMu.RUnlock()
+
+	return s.tasks[taskIndex], nil
+}
+
+func (s *MemoryTaskStore) GetTaskByAlertHash(rollupChainId uint32, alertHash [32]byte) (*message.AlertTaskInfo, error) {
+	s.tasksMu.RLock()
+	defer s.tasksMu.RUnlock()
+
+	// use the latest task for the alert
+	var res *message.AlertTaskInfo
+	for _, task := range s.tasks {
+		if task.RollupChainId != rollupChainId || task.AlertHash != alertHash {
+			continue
+		}
+		if res == nil || res.TaskIndex < task.TaskIndex {
+			res = task
+		}
+	}
+
+	return res, nil
+}
+
+func (s *MemoryTaskStore) GetUnfinishedTasks() ([]*message.AlertTaskInfo, error) {
+	s.tasksMu.RLock()
+	defer s.tasksMu.RUnlock()
+
+	s.finishedTasksMu.RLock()
+	defer s.finishedTasksMu.RUnlock()
+
+	res := make([]*message.AlertTaskInfo, 0)
+	for _, task := range s.tasks {
+		if _, ok := s.finishedTasks[alertKey{task.RollupChainId, task.AlertHash}]; !ok {
+			res = append(res, task)
+		}
+	}
+
+	return res, nil
+}
+
+func (s *MemoryTaskStore) PutTaskStatus(status *TaskStatus) error {
+	s.taskStatusMu.Lock()
+	defer s.taskStatusMu.Unlock()
+
+	// keep a copy, so the status changed by caller will not affect the stored one
+	copied := *status
+	s.taskStatus[status.TaskIndex] = &copied
+
+	return nil
+}
+
+func (s *MemoryTaskStore) GetTaskStatus(taskIndex types.TaskIndex) (*TaskStatus, error) {
+	s.taskStatusMu.RLock()
+	defer s.taskStatusMu.RUnlock()
+
+	status, ok := s.taskStatus[taskIndex]
+	if !ok {
+		return nil, nil
+	}
+
+	copied := *status
+	return &copied, nil
+}
+
+func (s *MemoryTaskStore) ListTaskStatus(filter TaskFilter, start types.TaskIndex, limit int) ([]*TaskStatus, error) {
+	s.taskStatusMu.RLock()
+	defer s.taskStatusMu.RUnlock()
+
+	indexes := make([]types.TaskIndex, 0, len(s.taskStatus))
+	for taskIndex := range s.taskStatus {
+		if taskIndex >= start {
+			indexes = append(indexes, taskIndex)
+		}
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+
+	res := make([]*TaskStatus, 0)
+	for _, taskIndex := range indexes {
+		if len(res) >= limit {
+			break
+		}
+
+		if status := s.taskStatus[taskIndex]; filter.Match(status) {
+			copied := *status
+			res = append(res, &copied)
+		}
+	}
+
+	return res, nil
+}
+
+func (s *MemoryTaskStore) PutFinishedTask(rollupChainId uint32, alertHash [32]byte, finished *FinishedTaskStatus) error {
+	s.finishedTasksMu.Lock()
+	defer s.finishedTasksMu.Unlock()
+
+	s.finishedTasks[alertKey{rollupChainId, alertHash}] = finished
+
+	return nil
+}
+
+func (s *MemoryTaskStore) GetFinishedTaskByAlertHash(rollupChainId uint32, alertHash [32]byte) (*FinishedTaskStatus, error) {
+	s.finishedTasksMu.RLock()
+	defer s.finishedTasksMu.RUnlock()
+
+	return s.finishedTasks[alertKey{rollupChainId, alertHash}], nil
+}
+
+func (s *MemoryTaskStore) PutOperatorStatus(operatorAddr common.Address, status *OperatorStatus) error {
+	s.operatorStatusMu.Lock()
+	defer s.operatorStatusMu.Unlock()
+
+	s.operatorStatus[operatorAddr] = status
+
+	return nil
+}
+
+func (s *MemoryTaskStore) GetOperatorStatus(operatorAddr common.Address) (*OperatorStatus, error) {
+	s.operatorStatusMu.RLock()
+	defer s.operatorStatusMu.RUnlock()
+
+	return s.operatorStatus[operatorAddr], nil
+}
+
+func (s *MemoryTaskStore) Close() error {
+	return nil
+}
@@ -0,0 +1,286 @@
+package store
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb/pebble"
+
+	"github.com/alt-research/avs/legacy/aggregator/types"
+	"github.com/alt-research/avs/legacy/core/message"
+)
+
+const (
+	pebbleCacheSize = 16 // MB
+	pebbleHandles   = 16
+	pebbleNamespace = "mach/aggregator/store/"
+)
+
+var (
+	nextTaskIndexKey     = []byte("meta/nextTaskIndex")
+	taskKeyPrefix        = []byte("task/")
+	taskStatusPrefix     = []byte("status/")
+	alertKeyPrefix       = []byte("alert/")
+	finishedTaskPrefix   = []byte("finished/")
+	operatorStatusPrefix = []byte("operator/")
+)
+
+// PebbleTaskStore keeps all the datas in a pebble db, so the tasks can be reloaded after restart.
+//
+// The datas layout:
+//
+//	meta/nextTaskIndex           -> uint32 big endian
+//	task/<taskIndex>             -> json of AlertTaskInfo
+//	status/<taskIndex>           -> json of TaskStatus
+//	alert/<rollupChainId><alertHash>    -> the latest taskIndex for the alert
+//	finished/<rollupChainId><alertHash> -> json of FinishedTaskStatus
+//	operator/<operatorAddress>   -> json of OperatorStatus
+type PebbleTaskStore struct {
+	db *pebble.Database
+
+	// protect the next task index and the task with its alert index
+	mu sync.Mutex
+}
+
+var _ TaskStore = (*PebbleTaskStore)(nil)
+
+func NewPebbleTaskStore(path string) (*PebbleTaskStore, error) {
+	db, err := pebble.New(path, pebbleCacheSize, pebbleHandles, pebbleNamespace, false, false)
+	if err != nil {
+		return nil, fmt.Errorf("open pebble db %s failed: %w", path, err)
+	}
+
+	return &PebbleTaskStore{
+		db: db,
+	}, nil
+}
+
+func taskIndexToBytes(taskIndex types.TaskIndex) []byte {
+	return binary.BigEndian.AppendUint32(nil, taskIndex)
+}
+
+func taskKey(taskIndex types.TaskIndex) []byte {
+	return append(common.CopyBytes(taskKeyPrefix), taskIndexToBytes(taskIndex)...)
+}
+
+func taskStatusKey(taskIndex types.TaskIndex) []byte {
+	return append(common.CopyBytes(taskStatusPrefix), taskIndexToBytes(taskIndex)...)
+}
+
+// rollupAlertKey is the key of the alert in the rollup, the rollup chain id is in big endian.
+func rollupAlertKey(prefix []byte, rollupChainId uint32, alertHash [32]byte) []byte {
+	key := binary.BigEndian.AppendUint32(common.CopyBytes(prefix), rollupChainId)
+	return append(key, alertHash[:]...)
+}
+
+func alertTaskKey(rollupChainId uint32, alertHash [32]byte) []byte {
+	return rollupAlertKey(alertKeyPrefix, rollupChainId, alertHash)
+}
+
+func finishedTaskKey(rollupChainId uint32, alertHash [32]byte) []byte {
+	return rollupAlertKey(finishedTaskPrefix, rollupChainId, alertHash)
+}
+
+func operatorStatusKey(operatorAddr common.Address) []byte {
+	return append(common.CopyBytes(operatorStatusPrefix), operatorAddr.Bytes()...)
+}
+
+// get returns nil without error if the key not exist.
+func (s *PebbleTaskStore) get(key []byte) ([]byte, error) {
+	has, err := s.db.Has(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !has {
+		return nil, nil
+	}
+
+	return s.db.Get(key)
+}
+
+func (s *PebbleTaskStore) getJSON(key []byte, value interface{}) (bool, error) {
+	data, err := s.get(key)
+	if err != nil {
+		return false, err
+	}
+
+	if data == nil {
+		return false, nil
+	}
+
+	if err := json.Unmarshal(data, value); err != nil {
+		return false, fmt.Errorf("unmarshal %s failed: %w", key, err)
+	}
+
+	return true, nil
+}
+
+func (s *PebbleTaskStore) putJSON(key []byte, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Put(key, data)
+}
+
+func (s *PebbleTaskStore) NewTaskIndex() (types.TaskIndex, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := s.get(nextTaskIndexKey)
+	if err != nil {
+		return 0, err
+	}
+
+	var res types.TaskIndex
+	if data != nil {
+		res = binary.BigEndian.Uint32(data)
+	}
+
+	if err := s.db.Put(nextTaskIndexKey, taskIndexToBytes(res+1)); err != nil {
+		return 0, err
+	}
+
+	return res, nil
+}
+
+func (s *PebbleTaskStore) PutTask(task *message.AlertTaskInfo) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := json.Marshal(task)
+	if err != nil {
+		return err
+	}
+
+	batch := s.db.NewBatch()
+	if err := batch.Put(taskKey(task.TaskIndex), data); err != nil {
+		return err
+	}
+
+	// only point the alert to the latest task, the older task may be updated after a newer one created
+	latest, err := s.get(alertTaskKey(task.RollupChainId, task.AlertHash))
+	if err != nil {
+		return err
+	}
+	if latest == nil || binary.BigEndian.Uint32(latest) <= task.TaskIndex {
+		if err := batch.Put(alertTaskKey(task.RollupChainId, task.AlertHash), taskIndexToBytes(task.TaskIndex)); err != nil {
+			return err
+		}
+	}
+
+	return batch.Write()
+}
+
+func (s *PebbleTaskStore) GetTaskByIndex(taskIndex types.TaskIndex) (*message.AlertTaskInfo, error) {
+	task := &message.AlertTaskInfo{}
+	found, err := s.getJSON(taskKey(taskIndex), task)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+func (s *PebbleTaskStore) GetTaskByAlertHash(rollupChainId uint32, alertHash [32]byte) (*message.AlertTaskInfo, error) {
+	data, err := s.get(alertTaskKey(rollupChainId, alertHash))
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	return s.GetTaskByIndex(binary.BigEndian.Uint32(data))
+}
+
+func (s *PebbleTaskStore) GetUnfinishedTasks() ([]*message.AlertTaskInfo, error) {
+	it := s.db.NewIterator(taskKeyPrefix, nil)
+	defer it.Release()
+
+	res := make([]*message.AlertTaskInfo, 0)
+	for it.Next() {
+		task := &message.AlertTaskInfo{}
+		if err := json.Unmarshal(it.Value(), task); err != nil {
+			return nil, fmt.Errorf("unmarshal %s failed: %w", it.Key(), err)
+		}
+
+		finished, err := s.db.Has(finishedTaskKey(task.RollupChainId, task.AlertHash))
+		if err != nil {
+			return nil, err
+		}
+
+		if !finished {
+			res = append(res, task)
+		}
+	}
+
+	return res, it.Error()
+}
+
+func (s *PebbleTaskStore) PutTaskStatus(status *TaskStatus) error {
+	return s.putJSON(taskStatusKey(status.TaskIndex), status)
+}
+
+func (s *PebbleTaskStore) GetTaskStatus(taskIndex types.TaskIndex) (*TaskStatus, error) {
+	status := &TaskStatus{}
+	found, err := s.getJSON(taskStatusKey(taskIndex), status)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func (s *PebbleTaskStore) ListTaskStatus(filter TaskFilter, start types.TaskIndex, limit int) ([]*TaskStatus, error) {
+	it := s.db.NewIterator(taskStatusPrefix, taskIndexToBytes(start))
+	defer it.Release()
+
+	res := make([]*TaskStatus, 0)
+	for len(res) < limit && it.Next() {
+		status := &TaskStatus{}
+		if err := json.Unmarshal(it.Value(), status); err != nil {
+			return nil, fmt.Errorf("unmarshal %s failed: %w", it.Key(), err)
+		}
+
+		if filter.Match(status) {
+			res = append(res, status)
+		}
+	}
+
+	return res, it.Error()
+}
+
+func (s *PebbleTaskStore) PutFinishedTask(rollupChainId uint32, alertHash [32]byte, finished *FinishedTaskStatus) error {
+	return s.putJSON(finishedTaskKey(rollupChainId, alertHash), finished)
+}
+
+func (s *PebbleTaskStore) GetFinishedTaskByAlertHash(rollupChainId uint32, alertHash [32]byte) (*FinishedTaskStatus, error) {
+	finished := &FinishedTaskStatus{}
+	found, err := s.getJSON(finishedTaskKey(rollupChainId, alertHash), finished)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	return finished, nil
+}
+
+func (s *PebbleTaskStore) PutOperatorStatus(operatorAddr common.Address, status *OperatorStatus) error {
+	return s.putJSON(operatorStatusKey(operatorAddr), status)
+}
+
+func (s *PebbleTaskStore) GetOperatorStatus(operatorAddr common.Address) (*OperatorStatus, error) {
+	status := &OperatorStatus{}
+	found, err := s.getJSON(operatorStatusKey(operatorAddr), status)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func (s *PebbleTaskStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,100 @@
+package store
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/alt-research/avs/legacy/aggregator/types"
+	"github.com/alt-research/avs/legacy/core/message"
+)
+
+// TaskState is the state of the task in aggregator, a task will be:
+//
+//	created -> collecting -> quorumReached -> submitted -> confirmed
+//
+// and the task can be expired or failed before it confirmed.
+type TaskState string
+
+const (
+	TaskStateCreated       TaskState = "created"
+	TaskStateCollecting    TaskState = "collecting"
+	TaskStateQuorumReached TaskState = "quorumReached"
+	TaskStateSubmitted     TaskState = "submitted"
+	TaskStateConfirmed     TaskState = "confirmed"
+	TaskStateExpired       TaskState = "expired"
+	TaskStateFailed        TaskState = "failed"
+)
+
+var taskStateTransitions = map[TaskState][]TaskState{
+	TaskStateCreated:       {TaskStateCollecting, TaskStateExpired, TaskStateFailed},
+	TaskStateCollecting:    {TaskStateQuorumReached, TaskStateExpired, TaskStateFailed},
+	TaskStateQuorumReached: {TaskStateSubmitted, TaskStateConfirmed, TaskStateExpired, TaskStateFailed},
+	// the submitted tx may be replaced by a tx with bumped fee
+	TaskStateSubmitted: {TaskStateSubmitted, TaskStateConfirmed, TaskStateFailed},
+}
+
+// IsTerminal returns if the task is in a terminal state, which will not be changed.
+func (s TaskState) IsTerminal() bool {
+	return s == TaskStateConfirmed || s == TaskStateExpired || s == TaskStateFailed
+}
+
+// IsValid returns if the state is a known state.
+func (s TaskState) IsValid() bool {
+	switch s {
+	case TaskStateCreated, TaskStateCollecting, TaskStateQuorumReached,
+		TaskStateSubmitted, TaskStateConfirmed, TaskStateExpired, TaskStateFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanTransitionTo returns if the task can change from the state to next.
+func (s TaskState) CanTransitionTo(next TaskState) bool {
+	for _, state := range taskStateTransitions[s] {
+		if state == next {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TaskStatus is the status for a task
+type TaskStatus struct {
+	TaskIndex     types.TaskIndex `json:"taskIndex"`
+	AlertHash     message.Bytes32 `json:"alertHash"`
+	RollupChainId uint32          `json:"rollupChainId"`
+	State         TaskState       `json:"state"`
+	// the reason why the task expired or failed
+	Reason string `json:"reason,omitempty"`
+	// the count of the operators which signed for the task
+	SignerCount uint32 `json:"signerCount"`
+	// the signed stake percentages for each quorum, set when quorum reached
+	SignedStakePercentages []uint8     `json:"signedStakePercentages,omitempty"`
+	TxHash                 common.Hash `json:"txHash,omitempty"`
+	BlockNumber            uint64      `json:"blockNumber,omitempty"`
+	UpdatedAt              int64       `json:"updatedAt"`
+}
+
+// TaskFilter is the filter to list the tasks status, the empty field will match all.
+type TaskFilter struct {
+	State         TaskState
+	AlertHash     *message.Bytes32
+	RollupChainId uint32
+}
+
+func (f TaskFilter) Match(status *TaskStatus) bool {
+	if f.State != "" && f.State != status.State {
+		return false
+	}
+
+	if f.AlertHash != nil && *f.AlertHash != status.AlertHash {
+		return false
+	}
+
+	if f.RollupChainId != 0 && f.RollupChainId != status.RollupChainId {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,71 @@
+package store
+
+import (
+	"math/big"
+
+	sdklogging "github.com/Layr-Labs/eigensdk-go/logging"
+	sdktypes "github.com/Layr-Labs/eigensdk-go/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/alt-research/avs/legacy/aggregator/types"
+	"github.com/alt-research/avs/legacy/core/message"
+)
+
+type FinishedTaskStatus struct {
+	Message          *message.AlertTaskInfo
+	TxHash           common.Hash
+	BlockHash        common.Hash `json:"blockHash,omitempty"`
+	BlockNumber      *big.Int    `json:"blockNumber,omitempty"`
+	TransactionIndex uint        `json:"transactionIndex"`
+}
+
+type OperatorStatus struct {
+	LastTime   int64               `json:"lastTime"`
+	OperatorId sdktypes.OperatorId `json:"operatorId"`
+}
+
+// TaskStore keeps the tasks, finished tasks and operators status for the aggregator,
+// the aggregator will reload the unfinished tasks from the store when restart.
+//
+// The task index is unique for all rollups, the alerts are kept by the rollup chain id,
+// so the same alert hash in different rollups are different tasks.
+//
+// All the getters return nil without error if the item is not found.
+type TaskStore interface {
+	// NewTaskIndex allocates a new task index, the index will never be reused.
+	NewTaskIndex() (types.TaskIndex, error)
+
+	// PutTask saves the task by its index, all the tasks for an alert are kept,
+	// the one with the largest task index is the latest task for the alert.
+	PutTask(task *message.AlertTaskInfo) error
+	GetTaskByIndex(taskIndex types.TaskIndex) (*message.AlertTaskInfo, error)
+	// GetTaskByAlertHash returns the latest task for the alert in the rollup.
+	GetTaskByAlertHash(rollupChainId uint32, alertHash [32]byte) (*message.AlertTaskInfo, error)
+	// GetUnfinishedTasks returns the tasks which not had a finished status.
+	GetUnfinishedTasks() ([]*message.AlertTaskInfo, error)
+
+	PutTaskStatus(status *TaskStatus) error
+	GetTaskStatus(taskIndex types.TaskIndex) (*TaskStatus, error)
+	// ListTaskStatus returns at most limit status matched the filter, which task index >= start, order by task index.
+	ListTaskStatus(filter TaskFilter, start types.TaskIndex, limit int) ([]*TaskStatus, error)
+
+	PutFinishedTask(rollupChainId uint32, alertHash [32]byte, finished *FinishedTaskStatus) error
+	GetFinishedTaskByAlertHash(rollupChainId uint32, alertHash [32]byte) (*FinishedTaskStatus, error)
+
+	PutOperatorStatus(operatorAddr common.Address, status *OperatorStatus) error
+	GetOperatorStatus(operatorAddr common.Address) (*OperatorStatus, error)
+
+	Close() error
+}
+
+// NewTaskStore creates the task store, if the path is empty, will use the in-memory store,
+// else will use a pebble db in the path.
+func NewTaskStore(logger sdklogging.Logger, path string) (TaskStore, error) {
+	if path == "" {
+		logger.Warn("task store path not set, the tasks will be lost when the aggregator restart")
+		return NewMemoryTaskStore(), nil
+	}
+
+	logger.Info("use pebble task store", "path", path)
+	return NewPebbleTaskStore(path)
+}
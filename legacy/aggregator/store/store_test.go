@@ -0,0 +1,275 @@
+package store
+
+import (
+	"math/big"
+	"reflect"
+	"sort"
+	"testing"
+
+	sdktypes "github.com/Layr-Labs/eigensdk-go/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/alt-research/avs/legacy/aggregator/types"
+	"github.com/alt-research/avs/legacy/core/message"
+)
+
+func newTestTask(taskIndex types.TaskIndex, rollupChainId uint32, alertHash [32]byte) *message.AlertTaskInfo {
+	return &message.AlertTaskInfo{
+		AlertHash:                  alertHash,
+		QuorumNumbers:              sdktypes.QuorumNums{0},
+		QuorumThresholdPercentages: sdktypes.QuorumThresholdPercentages{66},
+		TaskIndex:                  taskIndex,
+		ReferenceBlockNumber:       100 + uint64(taskIndex),
+		RollupChainId:              rollupChainId,
+	}
+}
+
+func TestTaskStore(t *testing.T) {
+	stores := []struct {
+		name string
+		open func(t *testing.T, path string) TaskStore
+		// if the tasks are kept after reopened
+		persistent bool
+	}{
+		{
+			name: "memory",
+			open: func(t *testing.T, path string) TaskStore {
+				return NewMemoryTaskStore()
+			},
+		},
+		{
+			name: "pebble",
+			open: func(t *testing.T, path string) TaskStore {
+				store, err := NewPebbleTaskStore(path)
+				if err != nil {
+					t.Fatalf("open the pebble task store failed: %v", err)
+				}
+				return store
+			},
+			persistent: true,
+		},
+	}
+
+	operatorAddr := common.HexToAddress("0x01")
+	operatorStatus := &OperatorStatus{LastTime: 1700000000, OperatorId: sdktypes.OperatorId{1}}
+
+	for _, s := range stores {
+		t.Run(s.name, func(t *testing.T) {
+			path := t.TempDir()
+			store := s.open(t, path)
+
+			for i := 0; i < 4; i++ {
+				taskIndex, err := store.NewTaskIndex()
+				if err != nil {
+					t.Fatalf("new task index failed: %v", err)
+				}
+				if taskIndex != types.TaskIndex(i) {
+					t.Fatalf("expect the task index %d, got %d", i, taskIndex)
+				}
+			}
+
+			// the task index 256 is before 2 if the key not in big endian
+			tasks := []*message.AlertTaskInfo{
+				newTestTask(256, 42, [32]byte{1}),
+				newTestTask(2, 42, [32]byte{2}),
+				// the same alert hash in another rollup
+				newTestTask(3, 43, [32]byte{2}),
+				// the task re-created for the alert 1 after the first expired
+				newTestTask(1, 42, [32]byte{1}),
+			}
+			for _, task := range tasks {
+				if err := store.PutTask(task); err != nil {
+					t.Fatalf("put the task %d failed: %v", task.TaskIndex, err)
+				}
+			}
+
+			statuses := []*TaskStatus{
+				{TaskIndex: 1, AlertHash: [32]byte{1}, RollupChainId: 42, State: TaskStateExpired, Reason: "expired by block height"},
+				{TaskIndex: 2, AlertHash: [32]byte{2}, RollupChainId: 42, State: TaskStateCollecting},
+				{TaskIndex: 3, AlertHash: [32]byte{2}, RollupChainId: 43, State: TaskStateCollecting},
+				{TaskIndex: 256, AlertHash: [32]byte{1}, RollupChainId: 42, State: TaskStateCreated},
+			}
+			for _, status := range statuses {
+				if err := store.PutTaskStatus(status); err != nil {
+					t.Fatalf("put the task status %d failed: %v", status.TaskIndex, err)
+				}
+			}
+
+			// update the status, the task is moved out from the collecting index
+			updated := *statuses[1]
+			updated.State = TaskStateSubmitted
+			updated.SignerCount = 2
+			updated.SignedStakePercentages = []uint8{70}
+			updated.TxHash = common.HexToHash("0x02")
+			if err := store.PutTaskStatus(&updated); err != nil {
+				t.Fatalf("update the task status failed: %v", err)
+			}
+			statuses[1] = &updated
+
+			finished := &FinishedTaskStatus{
+				Message:          tasks[2],
+				TxHash:           common.HexToHash("0x03"),
+				BlockHash:        common.HexToHash("0x04"),
+				BlockNumber:      big.NewInt(200),
+				TransactionIndex: 1,
+			}
+			if err := store.PutFinishedTask(43, [32]byte{2}, finished); err != nil {
+				t.Fatalf("put the finished task failed: %v", err)
+			}
+
+			if err := store.PutOperatorStatus(operatorAddr, operatorStatus); err != nil {
+				t.Fatalf("put the operator status failed: %v", err)
+			}
+
+			assertTaskStore(t, store, tasks, statuses, finished, operatorStatus)
+
+			if !s.persistent {
+				return
+			}
+
+			if err := store.Close(); err != nil {
+				t.Fatalf("close the store failed: %v", err)
+			}
+
+			reopened := s.open(t, path)
+			defer reopened.Close()
+
+			assertTaskStore(t, reopened, tasks, statuses, finished, operatorStatus)
+
+			// the task index will not be reused after reopened
+			taskIndex, err := reopened.NewTaskIndex()
+			if err != nil {
+				t.Fatalf("new task index failed: %v", err)
+			}
+			if taskIndex != 4 {
+				t.Fatalf("expect the task index 4 after reopened, got %d", taskIndex)
+			}
+		})
+	}
+}
+
+// assertTaskStore checks the store had the tasks and status put by TestTaskStore.
+func assertTaskStore(
+	t *testing.T,
+	store TaskStore,
+	tasks []*message.AlertTaskInfo,
+	statuses []*TaskStatus,
+	finished *FinishedTaskStatus,
+	operatorStatus *OperatorStatus,
+) {
+	t.Helper()
+
+	for _, task := range tasks {
+		got, err := store.GetTaskByIndex(task.TaskIndex)
+		if err != nil {
+			t.Fatalf("get the task %d failed: %v", task.TaskIndex, err)
+		}
+		if !reflect.DeepEqual(got, task) {
+			t.Fatalf("the task %d mismatch, expect %+v, got %+v", task.TaskIndex, task, got)
+		}
+	}
+
+	missing, err := store.GetTaskByIndex(1000)
+	if err != nil || missing != nil {
+		t.Fatalf("expect no task 1000, got %+v, %v", missing, err)
+	}
+
+	latests := []struct {
+		rollupChainId uint32
+		alertHash     [32]byte
+		taskIndex     types.TaskIndex
+	}{
+		// the latest task is the one with the largest index, not the last put one
+		{rollupChainId: 42, alertHash: [32]byte{1}, taskIndex: 256},
+		{rollupChainId: 42, alertHash: [32]byte{2}, taskIndex: 2},
+		{rollupChainId: 43, alertHash: [32]byte{2}, taskIndex: 3},
+	}
+	for _, latest := range latests {
+		got, err := store.GetTaskByAlertHash(latest.rollupChainId, latest.alertHash)
+		if err != nil {
+			t.Fatalf("get the task of alert %x failed: %v", latest.alertHash, err)
+		}
+		if got == nil || got.TaskIndex != latest.taskIndex {
+			t.Fatalf("expect the latest task %d of alert %x in rollup %d, got %+v", latest.taskIndex, latest.alertHash, latest.rollupChainId, got)
+		}
+	}
+
+	// the alert finished in the rollup 43 should not affect the same alert in rollup 42
+	unfinished, err := store.GetUnfinishedTasks()
+	if err != nil {
+		t.Fatalf("get the unfinished tasks failed: %v", err)
+	}
+	unfinishedIndexes := make([]types.TaskIndex, 0, len(unfinished))
+	for _, task := range unfinished {
+		unfinishedIndexes = append(unfinishedIndexes, task.TaskIndex)
+	}
+	sort.Slice(unfinishedIndexes, func(i, j int) bool { return unfinishedIndexes[i] < unfinishedIndexes[j] })
+	if !reflect.DeepEqual(unfinishedIndexes, []types.TaskIndex{1, 2, 256}) {
+		t.Fatalf("expect the unfinished tasks [1 2 256], got %v", unfinishedIndexes)
+	}
+
+	for _, status := range statuses {
+		got, err := store.GetTaskStatus(status.TaskIndex)
+		if err != nil {
+			t.Fatalf("get the task status %d failed: %v", status.TaskIndex, err)
+		}
+		if !reflect.DeepEqual(got, status) {
+			t.Fatalf("the task status %d mismatch, expect %+v, got %+v", status.TaskIndex, status, got)
+		}
+	}
+
+	alertHash := message.Bytes32{2}
+	filters := []struct {
+		name    string
+		filter  TaskFilter
+		start   types.TaskIndex
+		limit   int
+		indexes []types.TaskIndex
+	}{
+		{name: "all", limit: 10, indexes: []types.TaskIndex{1, 2, 3, 256}},
+		{name: "start and limit", start: 2, limit: 2, indexes: []types.TaskIndex{2, 3}},
+		{name: "state updated", filter: TaskFilter{State: TaskStateCollecting}, limit: 10, indexes: []types.TaskIndex{3}},
+		{name: "alert", filter: TaskFilter{AlertHash: &alertHash}, limit: 10, indexes: []types.TaskIndex{2, 3}},
+		{name: "rollup", filter: TaskFilter{RollupChainId: 42}, limit: 10, indexes: []types.TaskIndex{1, 2, 256}},
+	}
+	for _, f := range filters {
+		res, err := store.ListTaskStatus(f.filter, f.start, f.limit)
+		if err != nil {
+			t.Fatalf("list the task status by %s failed: %v", f.name, err)
+		}
+
+		indexes := make([]types.TaskIndex, 0, len(res))
+		for _, status := range res {
+			indexes = append(indexes, status.TaskIndex)
+		}
+		if !reflect.DeepEqual(indexes, f.indexes) {
+			t.Fatalf("list the task status by %s, expect %v, got %v", f.name, f.indexes, indexes)
+		}
+	}
+
+	gotFinished, err := store.GetFinishedTaskByAlertHash(43, [32]byte{2})
+	if err != nil {
+		t.Fatalf("get the finished task failed: %v", err)
+	}
+	if gotFinished == nil ||
+		gotFinished.TxHash != finished.TxHash ||
+		gotFinished.BlockHash != finished.BlockHash ||
+		gotFinished.BlockNumber.Cmp(finished.BlockNumber) != 0 ||
+		gotFinished.TransactionIndex != finished.TransactionIndex ||
+		!reflect.DeepEqual(gotFinished.Message, finished.Message) {
+		t.Fatalf("the finished task mismatch, expect %+v, got %+v", finished, gotFinished)
+	}
+
+	notFinished, err := store.GetFinishedTaskByAlertHash(42, [32]byte{2})
+	if err != nil || notFinished != nil {
+		t.Fatalf("expect the alert not finished in rollup 42, got %+v, %v", notFinished, err)
+	}
+
+	gotOperatorStatus, err := store.GetOperatorStatus(common.HexToAddress("0x01"))
+	if err != nil {
+		t.Fatalf("get the operator status failed: %v", err)
+	}
+	if !reflect.DeepEqual(gotOperatorStatus, operatorStatus) {
+		t.Fatalf("the operator status mismatch, expect %+v, got %+v", operatorStatus, gotOperatorStatus)
+	}
+}
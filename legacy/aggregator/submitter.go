@@ -0,0 +1,489 @@
+package aggregator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/clients/eth"
+	"github.com/Layr-Labs/eigensdk-go/chainio/txmgr"
+	"github.com/Layr-Labs/eigensdk-go/logging"
+	"github.com/Layr-Labs/eigensdk-go/signerv2"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	csservicemanager "github.com/alt-research/avs/contracts/bindings/MachServiceManager"
+	"github.com/alt-research/avs/legacy/aggregator/types"
+	"github.com/alt-research/avs/legacy/core/chainio"
+	"github.com/alt-research/avs/legacy/core/config"
+	"github.com/alt-research/avs/legacy/core/message"
+)
+
+const (
+	submitQueueSize      = 128
+	receiptQueryInterval = 2 * time.Second
+)
+
+var (
+	errAlertAlreadyConfirmed = errors.New("the alert already confirmed")
+	errTxStuck               = errors.New("the tx not mined after max fee bumps")
+	errConfirmDeadline       = errors.New("the task reached its deadline block")
+)
+
+// ConfirmAlertRequest is the request to send the confirm alert tx for a task.
+type ConfirmAlertRequest struct {
+	Task                        *message.AlertTaskInfo
+	NonSignerStakesAndSignature csservicemanager.IBLSSignatureCheckerNonSignerStakesAndSignature
+	// the layer1 block at which the submitter stops sending the tx for the task, 0 means no deadline
+	DeadlineBlock uint64
+
+	// the tx sent for the request, kept across the retries so the stuck tx will be replaced at the same nonce
+	tx *pendingConfirmTx
+}
+
+// pendingConfirmTx is the nonce used by the confirm alert txs, with the last fees and the txs sent at it.
+type pendingConfirmTx struct {
+	nonce     uint64
+	gasTipCap *big.Int
+	gasFeeCap *big.Int
+	// the txs sent at the nonce, any of them may be mined
+	sent []common.Hash
+}
+
+// ConfirmAlertHandler handles the results for the confirm alert requests.
+type ConfirmAlertHandler interface {
+	// OnConfirmAlertSubmitted is called when a tx sent, a task may had more than one tx by fee bumps.
+	OnConfirmAlertSubmitted(task *message.AlertTaskInfo, txHash common.Hash)
+	// OnConfirmAlertFinished is called when the request finished, the receipt is nil
+	// if the alert is confirmed by others or failed.
+	OnConfirmAlertFinished(task *message.AlertTaskInfo, receipt *gethtypes.Receipt, err error)
+}
+
+// ConfirmAlertSubmitter is the queue to send the confirm alert txs, it keeps the aggregated signatures
+// for each task and retries with backoff, the stuck tx will be replaced by a tx with bumped fee.
+type ConfirmAlertSubmitter struct {
+	logger    logging.Logger
+	policy    config.ConfirmAlertPolicy
+	rollups   map[uint32]*rollupChain
+	ethClient eth.Client
+	signerFn  signerv2.SignerFn
+	sender    common.Address
+	handler   ConfirmAlertHandler
+
+	queue     chan *ConfirmAlertRequest
+	pending   map[types.TaskIndex]*ConfirmAlertRequest
+	pendingMu sync.RWMutex
+
+	// the tx of the finished request which not mined, the next request will replace it at the same nonce,
+	// so the later txs will not be blocked by it, only used by the `Start` goroutine.
+	unmined *pendingConfirmTx
+}
+
+func NewConfirmAlertSubmitter(
+	c *config.Config,
+	rollups map[uint32]*rollupChain,
+	handler ConfirmAlertHandler,
+) *ConfirmAlertSubmitter {
+	return &ConfirmAlertSubmitter{
+		logger:    c.Logger,
+		policy:    c.ConfirmAlertPolicy.WithDefaults(),
+		rollups:   rollups,
+		ethClient: c.EthHttpClient,
+		signerFn:  c.SignerFn,
+		sender:    c.AggregatorAddress,
+		handler:   handler,
+		queue:     make(chan *ConfirmAlertRequest, submitQueueSize),
+		pending:   make(map[types.TaskIndex]*ConfirmAlertRequest),
+	}
+}
+
+// Submit pushes the request into the queue, the request with same task index will be ignored.
+func (s *ConfirmAlertSubmitter) Submit(req *ConfirmAlertRequest) error {
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+
+	if _, ok := s.pending[req.Task.TaskIndex]; ok {
+		s.logger.Warn("the confirm alert request already in queue", "taskIndex", req.Task.TaskIndex)
+		return nil
+	}
+
+	select {
+	case s.queue <- req:
+		s.pending[req.Task.TaskIndex] = req
+		return nil
+	default:
+		return fmt.Errorf("the confirm alert queue is full")
+	}
+}
+
+// GetPendingRequest returns the request which not finished for the task.
+func (s *ConfirmAlertSubmitter) GetPendingRequest(taskIndex types.TaskIndex) *ConfirmAlertRequest {
+	s.pendingMu.RLock()
+	defer s.pendingMu.RUnlock()
+
+	return s.pending[taskIndex]
+}
+
+// Start handles the requests one by one, so the txs from the aggregator will not conflict in nonce.
+func (s *ConfirmAlertSubmitter) Start(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case req := <-s.queue:
+			receipt, err := s.process(ctx, req)
+			if ctx.Err() != nil {
+				return
+			}
+
+			if receipt == nil && req.tx != nil && len(req.tx.sent) != 0 {
+				s.logger.Warn("the confirm alert tx not mined, the next request will replace it",
+					"taskIndex", req.Task.TaskIndex, "nonce", req.tx.nonce)
+				s.unmined = &pendingConfirmTx{
+					nonce:     req.tx.nonce,
+					gasTipCap: req.tx.gasTipCap,
+					gasFeeCap: req.tx.gasFeeCap,
+				}
+			}
+
+			s.pendingMu.Lock()
+			delete(s.pending, req.Task.TaskIndex)
+			s.pendingMu.Unlock()
+
+			s.handler.OnConfirmAlertFinished(req.Task, receipt, err)
+		}
+	}
+}
+
+func (s *ConfirmAlertSubmitter) process(ctx context.Context, req *ConfirmAlertRequest) (*gethtypes.Receipt, error) {
+	rollup, ok := s.rollups[req.Task.RollupChainId]
+	if !ok {
+		return nil, fmt.Errorf("the rollup %d not served by the aggregator", req.Task.RollupChainId)
+	}
+
+	if s.unmined != nil {
+		req.tx, s.unmined = s.unmined, nil
+	}
+
+	backoff := s.policy.RetryInterval
+	maxRetries := *s.policy.MaxRetries
+
+	var lastErr error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if s.deadlinePassed(ctx, req) {
+			return nil, fmt.Errorf("%w %d, last error: %v", errConfirmDeadline, req.DeadlineBlock, lastErr)
+		}
+
+		if attempt > 0 {
+			s.logger.Warn(
+				"retry send confirm alert",
+				"taskIndex", req.Task.TaskIndex,
+				"attempt", attempt,
+				"backoff", backoff,
+				"err", lastErr,
+			)
+
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(backoff):
+			}
+
+			backoff *= 2
+			if backoff > s.policy.MaxRetryInterval {
+				backoff = s.policy.MaxRetryInterval
+			}
+		}
+
+		confirmed, err := rollup.avsReader.IsAlertContains(ctx, rollup.cfg.ChainId, req.Task.AlertHash)
+		if err != nil {
+			lastErr = fmt.Errorf("check alert contains failed: %w", err)
+			continue
+		}
+		if confirmed {
+			s.logger.Info("the alert had been confirmed, skip send", "taskIndex", req.Task.TaskIndex, "alert", req.Task.AlertHash)
+			return nil, errAlertAlreadyConfirmed
+		}
+
+		receipt, err := s.send(ctx, rollup, req)
+		if err == nil {
+			return receipt, nil
+		}
+
+		if errors.Is(err, errAlertAlreadyConfirmed) || errors.Is(err, errConfirmDeadline) {
+			return nil, err
+		}
+
+		lastErr = err
+	}
+
+	return nil, fmt.Errorf("send confirm alert failed after %d retries: %w", maxRetries, lastErr)
+}
+
+// deadlinePassed returns true if the layer1 reached the deadline block of the request,
+// the deadline is not checked if failed to get the head block.
+func (s *ConfirmAlertSubmitter) deadlinePassed(ctx context.Context, req *ConfirmAlertRequest) bool {
+	if req.DeadlineBlock == 0 {
+		return false
+	}
+
+	head, err := s.ethClient.BlockNumber(ctx)
+	if err != nil {
+		s.logger.Warn("get the head block for the confirm alert deadline failed", "taskIndex", req.Task.TaskIndex, "err", err)
+		return false
+	}
+
+	return head >= req.DeadlineBlock
+}
+
+// send sends the tx, and replaces it with bumped fee if it not be mined after the resubmit timeout,
+// if the request had sent a tx not mined, will replace it at the same nonce with the fees bumped from it.
+func (s *ConfirmAlertSubmitter) send(ctx context.Context, rollup *rollupChain, req *ConfirmAlertRequest) (*gethtypes.Receipt, error) {
+	rawTx, err := rollup.avsWriter.BuildConfirmAlertTx(ctx, req.Task, req.NonSignerStakesAndSignature)
+	if err != nil {
+		return nil, fmt.Errorf("build confirm alert tx failed: %w", err)
+	}
+
+	chainId, err := s.ethClient.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the nonce may be used by the tx mined or the others when retrying
+	if req.tx != nil {
+		minedNonce, err := s.ethClient.NonceAt(ctx, s.sender, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if minedNonce > req.tx.nonce {
+			if receipt := s.queryReceipts(ctx, req.tx.sent); receipt != nil {
+				return receipt, nil
+			}
+
+			s.logger.Info("the nonce of the confirm alert tx had been used", "taskIndex", req.Task.TaskIndex, "nonce", req.tx.nonce)
+			req.tx = nil
+		}
+	}
+
+	var gasTipCap, gasFeeCap *big.Int
+	if req.tx == nil {
+		nonce, err := s.ethClient.PendingNonceAt(ctx, s.sender)
+		if err != nil {
+			return nil, err
+		}
+
+		gasTipCap, gasFeeCap, err = s.suggestFees(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		req.tx = &pendingConfirmTx{nonce: nonce}
+	} else if req.tx.gasFeeCap == nil {
+		// no tx sent at the nonce yet
+		gasTipCap, gasFeeCap, err = s.suggestFees(ctx)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		s.logger.Warn("replace the unmined confirm alert tx", "taskIndex", req.Task.TaskIndex, "nonce", req.tx.nonce)
+
+		gasTipCap, gasFeeCap, err = s.bumpFees(ctx, req.tx.gasTipCap, req.tx.gasFeeCap)
+		if err != nil {
+			return nil, err
+		}
+	}
+	nonce := req.tx.nonce
+
+	gasLimit, err := s.ethClient.EstimateGas(ctx, ethereum.CallMsg{
+		From:      s.sender,
+		To:        rawTx.To(),
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+		Data:      rawTx.Data(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("estimate gas failed: %s", chainio.DecodeRevertReason(err))
+	}
+	gasLimit = uint64(float64(gasLimit) * s.policy.GasLimitMultiplier)
+
+	signer, err := s.signerFn(ctx, s.sender)
+	if err != nil {
+		return nil, err
+	}
+
+	for bumps := 0; ; bumps++ {
+		tx, err := signer(s.sender, gethtypes.NewTx(&gethtypes.DynamicFeeTx{
+			ChainID:   chainId,
+			Nonce:     nonce,
+			GasTipCap: gasTipCap,
+			GasFeeCap: gasFeeCap,
+			Gas:       gasLimit,
+			To:        rawTx.To(),
+			Data:      rawTx.Data(),
+		}))
+		if err != nil {
+			return nil, fmt.Errorf("sign tx failed: %w", err)
+		}
+
+		if err := s.ethClient.SendTransaction(ctx, tx); err != nil {
+			// the previous txs may be mined, so just check the receipts
+			if receipt := s.queryReceipts(ctx, req.tx.sent); receipt != nil {
+				return receipt, nil
+			}
+
+			// if nonce too low after sent, the previous tx had be mined, so wait its receipt.
+			if !isNonceTooLowErr(err) || len(req.tx.sent) == 0 {
+				return nil, fmt.Errorf("send tx failed: %w", err)
+			}
+		} else {
+			req.tx.sent = append(req.tx.sent, tx.Hash())
+			req.tx.gasTipCap, req.tx.gasFeeCap = gasTipCap, gasFeeCap
+			s.logger.Info(
+				"sent confirm alert tx",
+				"taskIndex", req.Task.TaskIndex,
+				"txHash", tx.Hash(),
+				"nonce", nonce,
+				"gasTipCap", gasTipCap,
+				"gasFeeCap", gasFeeCap,
+			)
+			s.handler.OnConfirmAlertSubmitted(req.Task, tx.Hash())
+		}
+
+		receipt, err := s.waitReceipts(ctx, req.tx.sent, s.policy.ResubmitTimeout)
+		if err != nil || receipt != nil {
+			return receipt, err
+		}
+
+		// the nonce had been used by others, so the alert may be confirmed
+		confirmed, err := rollup.avsReader.IsAlertContains(ctx, rollup.cfg.ChainId, req.Task.AlertHash)
+		if err == nil && confirmed {
+			return nil, errAlertAlreadyConfirmed
+		}
+
+		if s.deadlinePassed(ctx, req) {
+			return nil, fmt.Errorf("%w %d, the tx at nonce %d not mined", errConfirmDeadline, req.DeadlineBlock, nonce)
+		}
+
+		if bumps >= s.policy.MaxFeeBumps {
+			return nil, errTxStuck
+		}
+
+		gasTipCap, gasFeeCap, err = s.bumpFees(ctx, gasTipCap, gasFeeCap)
+		if err != nil {
+			return nil, err
+		}
+
+		s.logger.Warn("the confirm alert tx stuck, replace it with bumped fees", "taskIndex", req.Task.TaskIndex, "bumps", bumps+1)
+	}
+}
+
+func (s *ConfirmAlertSubmitter) suggestFees(ctx context.Context) (*big.Int, *big.Int, error) {
+	gasTipCap, err := s.ethClient.SuggestGasTipCap(ctx)
+	if err != nil {
+		s.logger.Info("eth_maxPriorityFeePerGas is unsupported by current backend, using fallback gasTipCap")
+		gasTipCap = txmgr.FallbackGasTipCap
+	}
+
+	header, err := s.ethClient.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 2*baseFee + gasTipCap makes sure that the tx remains includeable for 6 consecutive 100% full blocks.
+	gasFeeCap := new(big.Int).Add(new(big.Int).Mul(header.BaseFee, big.NewInt(2)), gasTipCap)
+
+	return gasTipCap, s.capGasFee(gasFeeCap), nil
+}
+
+// bumpFees bumps the fees by the policy, if the current suggested fees is higher, will use the suggested.
+func (s *ConfirmAlertSubmitter) bumpFees(ctx context.Context, gasTipCap, gasFeeCap *big.Int) (*big.Int, *big.Int, error) {
+	bump := func(v *big.Int) *big.Int {
+		res := new(big.Int).Mul(v, big.NewInt(int64(100+s.policy.FeeBumpPercent)))
+		return res.Div(res, big.NewInt(100))
+	}
+
+	newGasTipCap, newGasFeeCap := bump(gasTipCap), bump(gasFeeCap)
+
+	suggestGasTipCap, suggestGasFeeCap, err := s.suggestFees(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if suggestGasTipCap.Cmp(newGasTipCap) > 0 {
+		newGasTipCap = suggestGasTipCap
+	}
+	if suggestGasFeeCap.Cmp(newGasFeeCap) > 0 {
+		newGasFeeCap = suggestGasFeeCap
+	}
+
+	newGasFeeCap = s.capGasFee(newGasFeeCap)
+	if newGasFeeCap.Cmp(gasFeeCap) <= 0 {
+		return nil, nil, fmt.Errorf("the gas fee cap reached the max %d gwei", s.policy.MaxGasFeeCapGwei)
+	}
+	if newGasTipCap.Cmp(newGasFeeCap) > 0 {
+		newGasTipCap = newGasFeeCap
+	}
+
+	return newGasTipCap, newGasFeeCap, nil
+}
+
+func (s *ConfirmAlertSubmitter) capGasFee(gasFeeCap *big.Int) *big.Int {
+	if s.policy.MaxGasFeeCapGwei == 0 {
+		return gasFeeCap
+	}
+
+	maxGasFeeCap := new(big.Int).Mul(new(big.Int).SetUint64(s.policy.MaxGasFeeCapGwei), big.NewInt(params.GWei))
+	if gasFeeCap.Cmp(maxGasFeeCap) > 0 {
+		return maxGasFeeCap
+	}
+
+	return gasFeeCap
+}
+
+// waitReceipts waits the receipt for any of the txs, returns nil without error if timeout.
+func (s *ConfirmAlertSubmitter) waitReceipts(ctx context.Context, txHashes []common.Hash, timeout time.Duration) (*gethtypes.Receipt, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	ticker := time.NewTicker(receiptQueryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+			return nil, nil
+		case <-ticker.C:
+			if receipt := s.queryReceipts(ctx, txHashes); receipt != nil {
+				return receipt, nil
+			}
+		}
+	}
+}
+
+func (s *ConfirmAlertSubmitter) queryReceipts(ctx context.Context, txHashes []common.Hash) *gethtypes.Receipt {
+	for _, txHash := range txHashes {
+		receipt, err := s.ethClient.TransactionReceipt(ctx, txHash)
+		if err == nil && receipt != nil {
+			return receipt
+		}
+
+		if err != nil && !errors.Is(err, ethereum.NotFound) {
+			s.logger.Warn("query receipt failed", "txHash", txHash, "err", err)
+		}
+	}
+
+	return nil
+}
+
+func isNonceTooLowErr(err error) bool {
+	return strings.Contains(err.Error(), "nonce too low")
+}
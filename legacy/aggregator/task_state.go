@@ -0,0 +1,91 @@
+package aggregator
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alt-research/avs/legacy/aggregator/store"
+	"github.com/alt-research/avs/legacy/core/message"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SetTaskState changes the task into the state, the reason is used for the expired and failed state,
+// the invalid transition will be ignored, so the terminal state will not be changed.
+func (agg *AggregatorService) SetTaskState(task *message.AlertTaskInfo, state store.TaskState, reason string) error {
+	return agg.SetTaskStatus(task, state, reason, common.Hash{})
+}
+
+// SetTaskStatus changes the task into the state with the tx hash which send for the task.
+func (agg *AggregatorService) SetTaskStatus(task *message.AlertTaskInfo, state store.TaskState, reason string, txHash common.Hash) error {
+	agg.taskStatusMu.Lock()
+	defer agg.taskStatusMu.Unlock()
+
+	status, err := agg.store.GetTaskStatus(task.TaskIndex)
+	if err != nil {
+		return err
+	}
+
+	if status == nil {
+		if state != store.TaskStateCreated {
+			return fmt.Errorf("the task %d had no status", task.TaskIndex)
+		}
+
+		status = &store.TaskStatus{
+			TaskIndex:     task.TaskIndex,
+			AlertHash:     task.AlertHash,
+			RollupChainId: task.RollupChainId,
+		}
+	} else if !status.State.CanTransitionTo(state) {
+		agg.logger.Warn(
+			"the task state can not be changed",
+			"taskIndex", task.TaskIndex,
+			"from", status.State,
+			"to", state,
+		)
+		return nil
+	}
+
+	status.State = state
+	status.Reason = reason
+	status.UpdatedAt = time.Now().Unix()
+	if txHash != (common.Hash{}) {
+		status.TxHash = txHash
+	}
+
+	if err := agg.store.PutTaskStatus(status); err != nil {
+		return err
+	}
+
+	if state.IsTerminal() {
+		agg.clearTaskSigners(task.TaskIndex)
+	}
+
+	agg.metrics.IncNumTasksByState(string(state))
+	agg.publishTaskStatusEvent(status)
+
+	switch state {
+	case store.TaskStateExpired, store.TaskStateFailed:
+		agg.logger.Warn("task state changed", "taskIndex", task.TaskIndex, "alert", task.AlertHash, "state", state, "reason", reason)
+	default:
+		agg.logger.Info("task state changed", "taskIndex", task.TaskIndex, "alert", task.AlertHash, "state", state)
+	}
+
+	return nil
+}
+
+// updateTaskStatus updates the fields of the task status which not related to the state,
+// the update will be skipped if the task had no status.
+func (agg *AggregatorService) updateTaskStatus(task *message.AlertTaskInfo, update func(status *store.TaskStatus)) error {
+	agg.taskStatusMu.Lock()
+	defer agg.taskStatusMu.Unlock()
+
+	status, err := agg.store.GetTaskStatus(task.TaskIndex)
+	if err != nil || status == nil {
+		return err
+	}
+
+	update(status)
+	status.UpdatedAt = time.Now().Unix()
+
+	return agg.store.PutTaskStatus(status)
+}
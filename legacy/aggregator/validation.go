@@ -0,0 +1,138 @@
+package aggregator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	sdktypes "github.com/Layr-Labs/eigensdk-go/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/alt-research/avs/legacy/aggregator/types"
+	"github.com/alt-research/avs/legacy/core/message"
+)
+
+// validateSignedTaskResponse checks the signed task response before send it to the blsAggregationService:
+// the operator had inited, registered in the task quorums at the reference block, the signed alert matches
+// the stored task, and the BLS signature can be verified by the operator 's pubkeys.
+func (agg *AggregatorService) validateSignedTaskResponse(
+	ctx context.Context,
+	task *message.AlertTaskInfo,
+	signedTaskResponse *message.SignedTaskRespRequest,
+) error {
+	if !isSameTask(task, &signedTaskResponse.Alert) {
+		return message.NewAggregatorError(
+			message.ErrCodeTaskMismatch,
+			"the signed alert %#v not match the task %#v", signedTaskResponse.Alert, *task,
+		)
+	}
+
+	operatorId := signedTaskResponse.OperatorId
+	operator, err := agg.authenticator.getRegisteredOperator(ctx, operatorId)
+	if err != nil {
+		return err
+	}
+
+	operatorStatus, err := agg.store.GetOperatorStatus(operator.address)
+	if err != nil {
+		return err
+	}
+	if operatorStatus == nil || operatorStatus.OperatorId != operatorId {
+		return message.NewAggregatorError(message.ErrCodeOperatorNotInitialized, "operator %s had not inited", operator.address.Hex())
+	}
+
+	if err := agg.checkOperatorInQuorums(ctx, task, operatorId); err != nil {
+		return err
+	}
+
+	digest, err := task.SignHash()
+	if err != nil {
+		return err
+	}
+
+	ok, err := operator.g1Pubkey.VerifyEquivalence(operator.g2Pubkey)
+	if err != nil || !ok {
+		return message.NewAggregatorError(message.ErrCodeInvalidSignature, "the operator %s G1 and G2 pubkeys not match", operator.address.Hex())
+	}
+
+	ok, err = signedTaskResponse.BlsSignature.Verify(operator.g2Pubkey, digest)
+	if err != nil || !ok {
+		return message.NewAggregatorError(message.ErrCodeInvalidSignature, "the signature of operator %s can not be verified", operator.address.Hex())
+	}
+
+	return nil
+}
+
+// checkOperatorInQuorums checks the operator had stake in one of the task quorums at the reference block.
+func (agg *AggregatorService) checkOperatorInQuorums(ctx context.Context, task *message.AlertTaskInfo, operatorId sdktypes.OperatorId) error {
+	quorums, _, err := agg.avsReader.GetOperatorsStakeInQuorumsOfOperatorAtBlock(
+		&bind.CallOpts{Context: ctx}, operatorId, uint32(task.ReferenceBlockNumber),
+	)
+	if err != nil {
+		// the call will be reverted if the operator not registered at the block
+		if _, ok := err.(interface{ ErrorData() interface{} }); ok {
+			return message.NewAggregatorError(
+				message.ErrCodeOperatorNotRegistered,
+				"operator 0x%x not registered at reference block %d: %v", operatorId, task.ReferenceBlockNumber, err,
+			)
+		}
+		return fmt.Errorf("get operator 0x%x quorums failed: %w", operatorId, err)
+	}
+
+	for _, quorum := range quorums {
+		for _, taskQuorum := range task.QuorumNumbers {
+			if quorum == taskQuorum {
+				return nil
+			}
+		}
+	}
+
+	return message.NewAggregatorError(
+		message.ErrCodeOperatorNotRegistered,
+		"operator 0x%x not in the quorums %v at reference block %d", operatorId, task.QuorumNumbers, task.ReferenceBlockNumber,
+	)
+}
+
+func isSameTask(task, alert *message.AlertTaskInfo) bool {
+	return task.AlertHash == alert.AlertHash &&
+		task.RollupChainId == alert.RollupChainId &&
+		task.TaskIndex == alert.TaskIndex &&
+		task.ReferenceBlockNumber == alert.ReferenceBlockNumber &&
+		bytes.Equal(task.QuorumNumbers.UnderlyingType(), alert.QuorumNumbers.UnderlyingType()) &&
+		bytes.Equal(task.QuorumThresholdPercentages.UnderlyingType(), alert.QuorumThresholdPercentages.UnderlyingType())
+}
+
+// addTaskSigner records the operator signed the task, returns false if the operator had signed.
+func (agg *AggregatorService) addTaskSigner(taskIndex types.TaskIndex, operatorId sdktypes.OperatorId) bool {
+	agg.taskSignersMu.Lock()
+	defer agg.taskSignersMu.Unlock()
+
+	signers, ok := agg.taskSigners[taskIndex]
+	if !ok {
+		signers = make(map[sdktypes.OperatorId]struct{})
+		agg.taskSigners[taskIndex] = signers
+	}
+
+	if _, ok := signers[operatorId]; ok {
+		return false
+	}
+
+	signers[operatorId] = struct{}{}
+
+	return true
+}
+
+func (agg *AggregatorService) removeTaskSigner(taskIndex types.TaskIndex, operatorId sdktypes.OperatorId) {
+	agg.taskSignersMu.Lock()
+	defer agg.taskSignersMu.Unlock()
+
+	delete(agg.taskSigners[taskIndex], operatorId)
+}
+
+// clearTaskSigners removes the signers for the task which will not accept signature any more.
+func (agg *AggregatorService) clearTaskSigners(taskIndex types.TaskIndex) {
+	agg.taskSignersMu.Lock()
+	defer agg.taskSignersMu.Unlock()
+
+	delete(agg.taskSigners, taskIndex)
+}
@@ -28,6 +28,12 @@ type AggregatorClient interface {
 	CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...grpc.CallOption) (*CreateTaskResponse, error)
 	// Send signed task for alert
 	ProcessSignedTaskResponse(ctx context.Context, in *SignedTaskRespRequest, opts ...grpc.CallOption) (*SignedTaskRespResponse, error)
+	// Get the status of a task by alert hash or task index
+	GetTaskStatus(ctx context.Context, in *GetTaskStatusRequest, opts ...grpc.CallOption) (*TaskStatus, error)
+	// List the tasks status by filter
+	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	// Subscribe the events of the tasks
+	SubscribeTaskEvents(ctx context.Context, in *SubscribeTaskEventsRequest, opts ...grpc.CallOption) (Aggregator_SubscribeTaskEventsClient, error)
 }
 
 type aggregatorClient struct {
@@ -65,6 +71,56 @@ func (c *aggregatorClient) ProcessSignedTaskResponse(ctx context.Context, in *Si
 	return out, nil
 }
 
+func (c *aggregatorClient) GetTaskStatus(ctx context.Context, in *GetTaskStatusRequest, opts ...grpc.CallOption) (*TaskStatus, error) {
+	out := new(TaskStatus)
+	err := c.cc.Invoke(ctx, "/aggregator.Aggregator/GetTaskStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aggregatorClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error) {
+	out := new(ListTasksResponse)
+	err := c.cc.Invoke(ctx, "/aggregator.Aggregator/ListTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aggregatorClient) SubscribeTaskEvents(ctx context.Context, in *SubscribeTaskEventsRequest, opts ...grpc.CallOption) (Aggregator_SubscribeTaskEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Aggregator_ServiceDesc.Streams[0], "/aggregator.Aggregator/SubscribeTaskEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aggregatorSubscribeTaskEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Aggregator_SubscribeTaskEventsClient interface {
+	Recv() (*TaskEvent, error)
+	grpc.ClientStream
+}
+
+type aggregatorSubscribeTaskEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *aggregatorSubscribeTaskEventsClient) Recv() (*TaskEvent, error) {
+	m := new(TaskEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AggregatorServer is the server API for Aggregator service.
 // All implementations must embed UnimplementedAggregatorServer
 // for forward compatibility
@@ -75,6 +131,12 @@ type AggregatorServer interface {
 	CreateTask(context.Context, *CreateTaskRequest) (*CreateTaskResponse, error)
 	// Send signed task for alert
 	ProcessSignedTaskResponse(context.Context, *SignedTaskRespRequest) (*SignedTaskRespResponse, error)
+	// Get the status of a task by alert hash or task index
+	GetTaskStatus(context.Context, *GetTaskStatusRequest) (*TaskStatus, error)
+	// List the tasks status by filter
+	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	// Subscribe the events of the tasks
+	SubscribeTaskEvents(*SubscribeTaskEventsRequest, Aggregator_SubscribeTaskEventsServer) error
 	mustEmbedUnimplementedAggregatorServer()
 }
 
@@ -91,6 +153,15 @@ func (UnimplementedAggregatorServer) CreateTask(context.Context, *CreateTaskRequ
 func (UnimplementedAggregatorServer) ProcessSignedTaskResponse(context.Context, *SignedTaskRespRequest) (*SignedTaskRespResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessSignedTaskResponse not implemented")
 }
+func (UnimplementedAggregatorServer) GetTaskStatus(context.Context, *GetTaskStatusRequest) (*TaskStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskStatus not implemented")
+}
+func (UnimplementedAggregatorServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
+}
+func (UnimplementedAggregatorServer) SubscribeTaskEvents(*SubscribeTaskEventsRequest, Aggregator_SubscribeTaskEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTaskEvents not implemented")
+}
 func (UnimplementedAggregatorServer) mustEmbedUnimplementedAggregatorServer() {}
 
 // UnsafeAggregatorServer may be embedded to opt out of forward compatibility for this service.
@@ -158,6 +229,63 @@ func _Aggregator_ProcessSignedTaskResponse_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Aggregator_GetTaskStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AggregatorServer).GetTaskStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aggregator.Aggregator/GetTaskStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AggregatorServer).GetTaskStatus(ctx, req.(*GetTaskStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Aggregator_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AggregatorServer).ListTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aggregator.Aggregator/ListTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AggregatorServer).ListTasks(ctx, req.(*ListTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Aggregator_SubscribeTaskEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTaskEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AggregatorServer).SubscribeTaskEvents(m, &aggregatorSubscribeTaskEventsServer{stream})
+}
+
+type Aggregator_SubscribeTaskEventsServer interface {
+	Send(*TaskEvent) error
+	grpc.ServerStream
+}
+
+type aggregatorSubscribeTaskEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *aggregatorSubscribeTaskEventsServer) Send(m *TaskEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Aggregator_ServiceDesc is the grpc.ServiceDesc for Aggregator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -177,7 +305,21 @@ var Aggregator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessSignedTaskResponse",
 			Handler:    _Aggregator_ProcessSignedTaskResponse_Handler,
 		},
+		{
+			MethodName: "GetTaskStatus",
+			Handler:    _Aggregator_GetTaskStatus_Handler,
+		},
+		{
+			MethodName: "ListTasks",
+			Handler:    _Aggregator_ListTasks_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeTaskEvents",
+			Handler:       _Aggregator_SubscribeTaskEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "aggregator/aggregator.proto",
 }
@@ -0,0 +1,49 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	sdkutils "github.com/Layr-Labs/eigensdk-go/utils"
+	"github.com/alt-research/avs/legacy/core/config"
+	"github.com/alt-research/avs/legacy/operator"
+	"github.com/urfave/cli"
+)
+
+func ListAlerts(ctx *cli.Context) error {
+	configPath := ctx.GlobalString(config.ConfigFileFlag.Name)
+	nodeConfig := config.NodeConfig{}
+
+	if configPath != "" {
+		err := sdkutils.ReadYamlConfig(configPath, &nodeConfig)
+		if err != nil {
+			return err
+		}
+		configJson, err := json.MarshalIndent(nodeConfig, "", "  ")
+		if err != nil {
+			log.Fatalf(err.Error())
+		}
+		log.Println("Config:", string(configJson))
+	}
+
+	// looking up the events from the genesis will take too many eth_getLogs calls
+	if !ctx.IsSet("from-block") {
+		return fmt.Errorf("the --from-block is required, such as the block the service manager deployed at")
+	}
+
+	err := operator.PrintConfirmedAlertsFromConfig(
+		context.Background(),
+		nodeConfig,
+		uint32(ctx.Uint64("rollup-chain-id")),
+		ctx.Uint64("start"),
+		ctx.Uint64("size"),
+		ctx.Uint64("from-block"),
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
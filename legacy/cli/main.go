@@ -55,6 +55,31 @@ func main() {
 			Usage:   "prints operator status as viewed from incredible squaring contracts",
 			Action:  actions.PrintOperatorStatus,
 		},
+		{
+			Name:    "list-alerts",
+			Aliases: []string{"la"},
+			Usage:   "lists the confirmed alerts of the rollup with the block and tx of their AlertConfirmed events",
+			Action:  actions.ListAlerts,
+			Flags: []cli.Flag{
+				cli.Uint64Flag{
+					Name:  "rollup-chain-id",
+					Usage: "the chain id of the rollup, use the layer2_chain_id in config if not set",
+				},
+				cli.Uint64Flag{
+					Name:  "start",
+					Usage: "the index of the first alert to list",
+				},
+				cli.Uint64Flag{
+					Name:  "size",
+					Usage: "the max count of the alerts to list",
+					Value: 100,
+				},
+				cli.Uint64Flag{
+					Name:  "from-block",
+					Usage: "(required) the block to look up the AlertConfirmed events from, such as the block the service manager deployed at",
+				},
+			},
+		},
 	}
 
 	err := app.Run(os.Args)
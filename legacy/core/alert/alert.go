@@ -47,6 +47,15 @@ type BigIntJSON struct {
 	v *big.Int
 }
 
+// BigInt returns a copy of the value, zero if not set.
+func (b BigIntJSON) BigInt() *big.Int {
+	if b.v == nil {
+		return new(big.Int)
+	}
+
+	return new(big.Int).Set(b.v)
+}
+
 func (b BigIntJSON) MarshalJSON() ([]byte, error) {
 	v := b.v.Uint64()
 
@@ -74,6 +83,12 @@ type Alert interface {
 	GetAVSName() string
 }
 
+// AVSNameSetter is implemented by the alerts which the avs name can be filled by the server,
+// if the alert not carries it.
+type AVSNameSetter interface {
+	SetAVSName(name string)
+}
+
 // The Alert Request Message
 type AlertRequest struct {
 	Alert   Alert
@@ -85,8 +100,10 @@ type AlertResponse struct {
 	Code      uint32
 	TxHash    [32]byte
 	TaskIndex uint32
-	Err       error
-	Msg       string
+	// the block number which the confirm alert tx included, 0 if not confirmed
+	BlockNumber uint64
+	Err         error
+	Msg         string
 }
 
 // AlertBlockMismatch is submit alert for verifier found a op block output mismatch.
@@ -123,6 +140,10 @@ func (a AlertBlockMismatch) GetAVSName() string {
 	return a.AVSName
 }
 
+func (a *AlertBlockMismatch) SetAVSName(name string) {
+	a.AVSName = name
+}
+
 var _ Alert = (*AlertBlockMismatch)(nil)
 
 //	AlertBlockOutputOracleMismatch is Submit alert for verifier found a op block output root mismatch.
@@ -156,6 +177,10 @@ func (a AlertBlockOutputOracleMismatch) GetAVSName() string {
 	return a.AVSName
 }
 
+func (a *AlertBlockOutputOracleMismatch) SetAVSName(name string) {
+	a.AVSName = name
+}
+
 var _ Alert = (*AlertBlockOutputOracleMismatch)(nil)
 
 //	AlertBlockOutputOracleMismatch is Submit alert for verifier found a op block output root mismatch.
@@ -180,4 +205,8 @@ func (a AlertBlockHashMismatch) GetAVSName() string {
 	return a.AVSName
 }
 
+func (a *AlertBlockHashMismatch) SetAVSName(name string) {
+	a.AVSName = name
+}
+
 var _ Alert = (*AlertBlockHashMismatch)(nil)
@@ -0,0 +1,121 @@
+package alert
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// Kind describes an alert type which the verifier sends by a JSON-RPC method.
+type Kind struct {
+	// the JSON-RPC method to send the alert, such as `alert_blockMismatch`
+	Method string
+	// the name of the alert type, used in logs
+	Name string
+	// Decode decodes the alert from the first param of the JSON-RPC request
+	Decode func(param json.RawMessage) (Alert, error)
+	// Validate checks the decoded alert before sending it, nil means no check
+	Validate func(alert Alert) error
+	// Hash returns the message hash of the alert which commit to avs contract
+	Hash func(alert Alert) [32]byte
+}
+
+// NewKind returns the kind for the alert type `T`, which is decoded as json to a `*T`,
+// and hashed by its `MessageHash`, the validate can be nil.
+func NewKind[T any, PT interface {
+	*T
+	Alert
+}](method, name string, validate func(alert PT) error) Kind {
+	kind := Kind{
+		Method: method,
+		Name:   name,
+		Decode: func(param json.RawMessage) (Alert, error) {
+			var alert PT = new(T)
+			if err := json.Unmarshal(param, alert); err != nil {
+				return nil, err
+			}
+			return alert, nil
+		},
+		Hash: func(alert Alert) [32]byte {
+			return alert.MessageHash()
+		},
+	}
+
+	if validate != nil {
+		kind.Validate = func(alert Alert) error {
+			a, ok := alert.(PT)
+			if !ok {
+				return fmt.Errorf("the alert %T is not %s", alert, name)
+			}
+			return validate(a)
+		}
+	}
+
+	return kind
+}
+
+// Registry keeps the alert kinds by the JSON-RPC method.
+type Registry struct {
+	mu    sync.RWMutex
+	kinds map[string]Kind
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		kinds: make(map[string]Kind),
+	}
+}
+
+// Register adds the alert kind, returns error if the method had been registered.
+func (r *Registry) Register(kind Kind) error {
+	if kind.Method == "" || kind.Decode == nil || kind.Hash == nil {
+		return fmt.Errorf("the alert kind %s should have the method, decoder and hash", kind.Name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.kinds[kind.Method]; ok {
+		return fmt.Errorf("the alert method %s had been registered", kind.Method)
+	}
+
+	r.kinds[kind.Method] = kind
+
+	return nil
+}
+
+// MustRegister is like `Register` but panics if failed, used to register the alert kinds in `init`.
+func (r *Registry) MustRegister(kind Kind) {
+	if err := r.Register(kind); err != nil {
+		panic(err)
+	}
+}
+
+// Lookup returns the alert kind of the JSON-RPC method.
+func (r *Registry) Lookup(method string) (Kind, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	kind, ok := r.kinds[method]
+	return kind, ok
+}
+
+// DefaultRegistry is the registry used by the operator JSON-RPC server, the new alert kinds
+// can be registered to it in `init`.
+var DefaultRegistry = NewRegistry()
+
+func init() {
+	DefaultRegistry.MustRegister(NewKind[AlertBlockMismatch]("alert_blockMismatch", "AlertBlockMismatch", func(a *AlertBlockMismatch) error {
+		if a.L2BlockNumber.v == nil {
+			return fmt.Errorf("the l2_block_number is required")
+		}
+		return nil
+	}))
+	DefaultRegistry.MustRegister(NewKind[AlertBlockOutputOracleMismatch]("alert_blockOutputOracleMismatch", "AlertBlockOutputOracleMismatch", func(a *AlertBlockOutputOracleMismatch) error {
+		if a.InvalidOutputIndex.v == nil {
+			return fmt.Errorf("the invalid_output_index is required")
+		}
+		return nil
+	}))
+	DefaultRegistry.MustRegister(NewKind[AlertBlockHashMismatch]("alert_blockHash", "AlertBlockHashMismatch", nil))
+}
@@ -0,0 +1,135 @@
+package chainio
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// the max block range for each `eth_getLogs` call when looking up the alert events,
+// most of the rpc providers limit the range of the logs query.
+const alertEventsBlockRange uint64 = 5000
+
+// ConfirmedAlert is an alert confirmed in the service manager.
+type ConfirmedAlert struct {
+	// the index of the alert in the confirmed alerts of the rollup
+	Index         uint64          `json:"index"`
+	RollupChainId uint32          `json:"rollupChainId"`
+	MessageHash   gethcommon.Hash `json:"messageHash"`
+	// the info of the `AlertConfirmed` event, zero if the event not found
+	AlertHeaderHash gethcommon.Hash `json:"alertHeaderHash"`
+	BlockNumber     uint64          `json:"blockNumber"`
+	TxHash          gethcommon.Hash `json:"txHash"`
+}
+
+// IsEventFound returns whether the `AlertConfirmed` event of the alert is found.
+func (a *ConfirmedAlert) IsEventFound() bool {
+	return a.TxHash != (gethcommon.Hash{})
+}
+
+func (r *AvsReader) CountAlerts(ctx context.Context, rollupChainId uint32) (uint64, error) {
+	total, err := r.AvsServiceBindings.ServiceManager.TotalAlerts(&bind.CallOpts{
+		Context: ctx,
+	}, big.NewInt(int64(rollupChainId)))
+	if err != nil {
+		return 0, err
+	}
+
+	if !total.IsUint64() {
+		return 0, fmt.Errorf("the total alerts %s overflow", total)
+	}
+
+	return total.Uint64(), nil
+}
+
+func (r *AvsReader) QueryMessageHashes(ctx context.Context, rollupChainId uint32, start *big.Int, querySize *big.Int) ([][32]byte, error) {
+	return r.AvsServiceBindings.ServiceManager.QueryMessageHashes(&bind.CallOpts{
+		Context: ctx,
+	}, big.NewInt(int64(rollupChainId)), start, querySize)
+}
+
+func (r *AvsReader) ListConfirmedAlerts(ctx context.Context, rollupChainId uint32, start, size uint64) ([]*ConfirmedAlert, error) {
+	total, err := r.CountAlerts(ctx, rollupChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	// the contract will revert if the start out of range, so just return empty
+	if start >= total || size == 0 {
+		return nil, nil
+	}
+
+	hashes, err := r.QueryMessageHashes(ctx, rollupChainId, new(big.Int).SetUint64(start), new(big.Int).SetUint64(size))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*ConfirmedAlert, 0, len(hashes))
+	for i, hash := range hashes {
+		res = append(res, &ConfirmedAlert{
+			Index:         start + uint64(i),
+			RollupChainId: rollupChainId,
+			MessageHash:   gethcommon.Hash(hash),
+		})
+	}
+
+	return res, nil
+}
+
+func (r *AvsReader) FindAlertConfirmedEvents(ctx context.Context, fromBlock uint64, alerts []*ConfirmedAlert) error {
+	pending := make(map[gethcommon.Hash][]*ConfirmedAlert, len(alerts))
+	for _, alert := range alerts {
+		if !alert.IsEventFound() {
+			pending[alert.MessageHash] = append(pending[alert.MessageHash], alert)
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	latest, err := r.AvsServiceBindings.ethClient.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+
+	for from := fromBlock; from <= latest && len(pending) != 0; from += alertEventsBlockRange {
+		to := from + alertEventsBlockRange - 1
+		if to > latest {
+			to = latest
+		}
+
+		it, err := r.AvsServiceBindings.ServiceManager.FilterAlertConfirmed(&bind.FilterOpts{
+			Start:   from,
+			End:     &to,
+			Context: ctx,
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("filter the alert confirmed events in [%d, %d] failed: %w", from, to, err)
+		}
+
+		for it.Next() {
+			messageHash := gethcommon.Hash(it.Event.MessageHash)
+			found, ok := pending[messageHash]
+			if !ok {
+				continue
+			}
+
+			for _, alert := range found {
+				alert.AlertHeaderHash = gethcommon.Hash(it.Event.AlertHeaderHash)
+				alert.BlockNumber = it.Event.Raw.BlockNumber
+				alert.TxHash = it.Event.Raw.TxHash
+			}
+			delete(pending, messageHash)
+		}
+		err = it.Error()
+		it.Close()
+		if err != nil {
+			return fmt.Errorf("iterate the alert confirmed events in [%d, %d] failed: %w", from, to, err)
+		}
+	}
+
+	return nil
+}
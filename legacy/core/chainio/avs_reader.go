@@ -23,7 +23,26 @@ type AvsReaderer interface {
 		ctx context.Context, msgHash [32]byte, quorumNumbers []byte, referenceBlockNumber uint32, nonSignerStakesAndSignature csservicemanager.IBLSSignatureCheckerNonSignerStakesAndSignature,
 	) (csservicemanager.IBLSSignatureCheckerQuorumStakeTotals, error)
 
-	IsAlertContains(ctx context.Context, messageHash [32]byte) (bool, error)
+	// IsAlertContains returns whether the alert is confirmed for the rollup.
+	IsAlertContains(ctx context.Context, rollupChainId uint32, messageHash [32]byte) (bool, error)
+
+	// CountAlerts returns the count of the confirmed alerts for the rollup.
+	CountAlerts(ctx context.Context, rollupChainId uint32) (uint64, error)
+
+	// QueryMessageHashes returns at most querySize confirmed alert hashes of the rollup from the start index.
+	QueryMessageHashes(ctx context.Context, rollupChainId uint32, start *big.Int, querySize *big.Int) ([][32]byte, error)
+
+	// ListConfirmedAlerts returns at most size confirmed alerts of the rollup from the start index,
+	// the event info of the alerts is not filled, use `FindAlertConfirmedEvents` to fill it.
+	ListConfirmedAlerts(ctx context.Context, rollupChainId uint32, start, size uint64) ([]*ConfirmedAlert, error)
+
+	// FindAlertConfirmedEvents fills the `AlertConfirmed` event info of the alerts by the logs from the fromBlock,
+	// note the event has no rollup chain id, if a message hash confirmed by multiple rollups of a service manager,
+	// the first event will be used.
+	FindAlertConfirmedEvents(ctx context.Context, fromBlock uint64, alerts []*ConfirmedAlert) error
+
+	// IsRollupChainIdValid returns whether the rollup chain id is enabled in the service manager.
+	IsRollupChainIdValid(ctx context.Context, rollupChainId uint32) (bool, error)
 
 	// GetQuorumsByBlockNumber
 	GetQuorumsByBlockNumber(ctx context.Context, blockNumber uint32) (sdktypes.QuorumNums, error)
@@ -43,8 +62,18 @@ var _ AvsReaderer = (*AvsReader)(nil)
 func BuildAvsReaderFromConfig(c *config.Config) (*AvsReader, error) {
 	return BuildAvsReader(c.RegistryCoordinatorAddr, c.OperatorStateRetrieverAddr, c.EthHttpClient, c.Logger)
 }
+
+// BuildAvsReaderForRollup builds the reader for the service manager of the rollup.
+func BuildAvsReaderForRollup(c *config.Config, rollup *config.RollupConfig) (*AvsReader, error) {
+	return BuildAvsReaderWithServiceManager(rollup.ServiceManagerAddr, c.RegistryCoordinatorAddr, c.OperatorStateRetrieverAddr, c.EthHttpClient, c.Logger)
+}
+
 func BuildAvsReader(registryCoordinatorAddr, operatorStateRetrieverAddr gethcommon.Address, ethHttpClient eth.Client, logger logging.Logger) (*AvsReader, error) {
-	avsManagersBindings, err := NewAvsManagersBindings(registryCoordinatorAddr, operatorStateRetrieverAddr, ethHttpClient, logger)
+	return BuildAvsReaderWithServiceManager(gethcommon.Address{}, registryCoordinatorAddr, operatorStateRetrieverAddr, ethHttpClient, logger)
+}
+
+func BuildAvsReaderWithServiceManager(serviceManagerAddr, registryCoordinatorAddr, operatorStateRetrieverAddr gethcommon.Address, ethHttpClient eth.Client, logger logging.Logger) (*AvsReader, error) {
+	avsManagersBindings, err := NewAvsManagersBindingsWithServiceManager(serviceManagerAddr, registryCoordinatorAddr, operatorStateRetrieverAddr, ethHttpClient, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +95,7 @@ func (r *AvsReader) CheckSignatures(
 	ctx context.Context, msgHash [32]byte, quorumNumbers []byte, referenceBlockNumber uint32, nonSignerStakesAndSignature csservicemanager.IBLSSignatureCheckerNonSignerStakesAndSignature,
 ) (csservicemanager.IBLSSignatureCheckerQuorumStakeTotals, error) {
 	stakeTotalsPerQuorum, _, err := r.AvsServiceBindings.ServiceManager.CheckSignatures(
-		&bind.CallOpts{}, msgHash, quorumNumbers, referenceBlockNumber, nonSignerStakesAndSignature,
+		&bind.CallOpts{Context: ctx}, msgHash, quorumNumbers, referenceBlockNumber, nonSignerStakesAndSignature,
 	)
 	if err != nil {
 		return csservicemanager.IBLSSignatureCheckerQuorumStakeTotals{}, err
@@ -74,10 +103,10 @@ func (r *AvsReader) CheckSignatures(
 	return stakeTotalsPerQuorum, nil
 }
 
-func (r *AvsReader) IsAlertContains(ctx context.Context, messageHash [32]byte) (bool, error) {
+func (r *AvsReader) IsAlertContains(ctx context.Context, rollupChainId uint32, messageHash [32]byte) (bool, error) {
 	isContain, err := r.AvsServiceBindings.ServiceManager.Contains(&bind.CallOpts{
 		Context: ctx,
-	}, messageHash)
+	}, big.NewInt(int64(rollupChainId)), messageHash)
 	if err != nil {
 		return false, err
 	}
@@ -85,6 +114,12 @@ func (r *AvsReader) IsAlertContains(ctx context.Context, messageHash [32]byte) (
 	return isContain, nil
 }
 
+func (r *AvsReader) IsRollupChainIdValid(ctx context.Context, rollupChainId uint32) (bool, error) {
+	return r.AvsServiceBindings.ServiceManager.RollupChainIDs(&bind.CallOpts{
+		Context: ctx,
+	}, big.NewInt(int64(rollupChainId)))
+}
+
 func (r *AvsReader) GetQuorumsByBlockNumber(ctx context.Context, blockNumber uint32) (sdktypes.QuorumNums, error) {
 	quorumCount, err := r.AvsRegistryReader.GetQuorumCount(&bind.CallOpts{
 		Context:     ctx,
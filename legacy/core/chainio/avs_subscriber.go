@@ -1,7 +1,6 @@
 package chainio
 
 import (
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/event"
 
@@ -13,7 +12,13 @@ import (
 )
 
 type AvsSubscriberer interface {
-	SubscribeToAlertConfirmed(newTaskCreatedChan chan *csservicemanager.ContractMachServiceManagerAlertConfirmed) event.Subscription
+	// SubscribeToAlertConfirmed subscribes the AlertConfirmed events from the head block, same as
+	// `ResubscribeToAlertConfirmed` from 0, the returned error is always nil.
+	SubscribeToAlertConfirmed(alertConfirmedChan chan *csservicemanager.ContractMachServiceManagerAlertConfirmed) (event.Subscription, error)
+
+	// ResubscribeToAlertConfirmed subscribes the AlertConfirmed events from the fromBlock, it will resubscribe
+	// and backfill the missed events if the ws connection dropped, the subscription ends only by unsubscribe.
+	ResubscribeToAlertConfirmed(fromBlock uint64, alertConfirmedChan chan<- *csservicemanager.ContractMachServiceManagerAlertConfirmed) event.Subscription
 }
 
 // Subscribers use a ws connection instead of http connection like Readers
@@ -50,13 +55,8 @@ func NewAvsSubscriber(avsContractBindings *AvsManagersBindings, logger sdkloggin
 	}
 }
 
-func (s *AvsSubscriber) SubscribeToAlertConfirmed(newTaskCreatedChan chan *csservicemanager.ContractMachServiceManagerAlertConfirmed) event.Subscription {
-	sub, err := s.AvsContractBindings.ServiceManager.WatchAlertConfirmed(
-		&bind.WatchOpts{}, newTaskCreatedChan, nil,
-	)
-	if err != nil {
-		s.logger.Error("Failed to subscribe to new TaskManager tasks", "err", err)
-	}
-	s.logger.Infof("Subscribed to new TaskManager tasks")
-	return sub
+// SubscribeToAlertConfirmed subscribes the AlertConfirmed events from the head block, the subscription will
+// resubscribe and backfill the missed events if the ws connection dropped, see `ResubscribeToAlertConfirmed`.
+func (s *AvsSubscriber) SubscribeToAlertConfirmed(alertConfirmedChan chan *csservicemanager.ContractMachServiceManagerAlertConfirmed) (event.Subscription, error) {
+	return s.ResubscribeToAlertConfirmed(0, alertConfirmedChan), nil
 }
@@ -2,6 +2,7 @@ package chainio
 
 import (
 	"context"
+	"math/big"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -23,6 +24,16 @@ type AvsWriterer interface {
 		alertHeader *message.AlertTaskInfo,
 		nonSignerStakesAndSignature csservicemanager.IBLSSignatureCheckerNonSignerStakesAndSignature,
 	) (*types.Receipt, error)
+
+	// BuildConfirmAlertTx builds the confirm alert tx without sending,
+	// the tx is not signed, the caller should set the nonce and fees then sign it.
+	BuildConfirmAlertTx(ctx context.Context,
+		alertHeader *message.AlertTaskInfo,
+		nonSignerStakesAndSignature csservicemanager.IBLSSignatureCheckerNonSignerStakesAndSignature,
+	) (*types.Transaction, error)
+
+	// SendRemoveAlert removes the confirmed alert of the rollup, only the owner of the service manager can remove it.
+	SendRemoveAlert(ctx context.Context, rollupChainId uint32, messageHash [32]byte) (*types.Receipt, error)
 }
 
 type AvsWriter struct {
@@ -38,8 +49,17 @@ func BuildAvsWriterFromConfig(c *config.Config) (*AvsWriter, error) {
 	return BuildAvsWriter(c.TxMgr, c.RegistryCoordinatorAddr, c.OperatorStateRetrieverAddr, c.EthHttpClient, c.Logger)
 }
 
+// BuildAvsWriterForRollup builds the writer for the service manager of the rollup.
+func BuildAvsWriterForRollup(c *config.Config, rollup *config.RollupConfig) (*AvsWriter, error) {
+	return BuildAvsWriterWithServiceManager(c.TxMgr, rollup.ServiceManagerAddr, c.RegistryCoordinatorAddr, c.OperatorStateRetrieverAddr, c.EthHttpClient, c.Logger)
+}
+
 func BuildAvsWriter(txMgr txmgr.TxManager, registryCoordinatorAddr, operatorStateRetrieverAddr gethcommon.Address, ethHttpClient eth.Client, logger logging.Logger) (*AvsWriter, error) {
-	avsServiceBindings, err := NewAvsManagersBindings(registryCoordinatorAddr, operatorStateRetrieverAddr, ethHttpClient, logger)
+	return BuildAvsWriterWithServiceManager(txMgr, gethcommon.Address{}, registryCoordinatorAddr, operatorStateRetrieverAddr, ethHttpClient, logger)
+}
+
+func BuildAvsWriterWithServiceManager(txMgr txmgr.TxManager, serviceManagerAddr, registryCoordinatorAddr, operatorStateRetrieverAddr gethcommon.Address, ethHttpClient eth.Client, logger logging.Logger) (*AvsWriter, error) {
+	avsServiceBindings, err := NewAvsManagersBindingsWithServiceManager(serviceManagerAddr, registryCoordinatorAddr, operatorStateRetrieverAddr, ethHttpClient, logger)
 	if err != nil {
 		logger.Error("Failed to create contract bindings", "err", err)
 		return nil, err
@@ -83,3 +103,42 @@ func (w *AvsWriter) SendConfirmAlert(ctx context.Context,
 	}
 	return receipt, nil
 }
+
+func (w *AvsWriter) BuildConfirmAlertTx(ctx context.Context,
+	alertHeader *message.AlertTaskInfo,
+	nonSignerStakesAndSignature csservicemanager.IBLSSignatureCheckerNonSignerStakesAndSignature,
+) (*types.Transaction, error) {
+	txOpts, err := w.TxMgr.GetNoSendTxOpts()
+	if err != nil {
+		w.logger.Errorf("Error getting tx opts")
+		return nil, err
+	}
+	txOpts.Context = ctx
+
+	// the nonce and fees will be set by the caller, so we just make the binding not fetch them
+	txOpts.Nonce = big.NewInt(0)
+	txOpts.GasLimit = 1
+	txOpts.GasTipCap = big.NewInt(0)
+	txOpts.GasFeeCap = big.NewInt(0)
+
+	return w.AvsContractBindings.ServiceManager.ConfirmAlert(txOpts, alertHeader.ToIMachServiceManagerAlertHeader(), nonSignerStakesAndSignature)
+}
+
+func (w *AvsWriter) SendRemoveAlert(ctx context.Context, rollupChainId uint32, messageHash [32]byte) (*types.Receipt, error) {
+	txOpts, err := w.TxMgr.GetNoSendTxOpts()
+	if err != nil {
+		w.logger.Errorf("Error getting tx opts")
+		return nil, err
+	}
+	tx, err := w.AvsContractBindings.ServiceManager.RemoveAlert(txOpts, big.NewInt(int64(rollupChainId)), messageHash)
+	if err != nil {
+		w.logger.Error("Error building RemoveAlert tx", "err", err)
+		return nil, err
+	}
+	receipt, err := w.TxMgr.Send(ctx, tx)
+	if err != nil {
+		w.logger.Errorf("Error submitting RemoveAlert tx")
+		return nil, err
+	}
+	return receipt, nil
+}
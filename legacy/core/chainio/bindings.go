@@ -19,13 +19,21 @@ type AvsManagersBindings struct {
 }
 
 func NewAvsManagersBindings(registryCoordinatorAddr, operatorStateRetrieverAddr gethcommon.Address, ethclient eth.Client, logger logging.Logger) (*AvsManagersBindings, error) {
+	return NewAvsManagersBindingsWithServiceManager(gethcommon.Address{}, registryCoordinatorAddr, operatorStateRetrieverAddr, ethclient, logger)
+}
+
+// NewAvsManagersBindingsWithServiceManager creates the bindings for the service manager of a rollup,
+// if the serviceManagerAddr is zero, will use the service manager of the registry coordinator.
+func NewAvsManagersBindingsWithServiceManager(serviceManagerAddr, registryCoordinatorAddr, operatorStateRetrieverAddr gethcommon.Address, ethclient eth.Client, logger logging.Logger) (*AvsManagersBindings, error) {
 	contractRegistryCoordinator, err := regcoord.NewContractRegistryCoordinator(registryCoordinatorAddr, ethclient)
 	if err != nil {
 		return nil, err
 	}
-	serviceManagerAddr, err := contractRegistryCoordinator.ServiceManager(&bind.CallOpts{})
-	if err != nil {
-		return nil, err
+	if serviceManagerAddr == (gethcommon.Address{}) {
+		serviceManagerAddr, err = contractRegistryCoordinator.ServiceManager(&bind.CallOpts{})
+		if err != nil {
+			return nil, err
+		}
 	}
 	contractServiceManager, err := csservicemanager.NewContractMachServiceManager(serviceManagerAddr, ethclient)
 	if err != nil {
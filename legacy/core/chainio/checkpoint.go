@@ -0,0 +1,74 @@
+package chainio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// IndexerCheckpointStore persists the next block to index, so the indexer can continue after restart.
+type IndexerCheckpointStore interface {
+	// LoadCheckpoint returns the next block to index, found is false if no checkpoint saved.
+	LoadCheckpoint() (nextBlock uint64, found bool, err error)
+	// SaveCheckpoint saves the next block to index, all the events before it had been emitted.
+	SaveCheckpoint(nextBlock uint64) error
+}
+
+type fileCheckpoint struct {
+	NextBlock uint64 `json:"nextBlock"`
+}
+
+// FileCheckpointStore keeps the checkpoint in a json file.
+type FileCheckpointStore struct {
+	path string
+}
+
+var _ IndexerCheckpointStore = (*FileCheckpointStore)(nil)
+
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{
+		path: path,
+	}
+}
+
+func (s *FileCheckpointStore) LoadCheckpoint() (uint64, bool, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("read the checkpoint %s failed: %w", s.path, err)
+	}
+
+	var checkpoint fileCheckpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return 0, false, fmt.Errorf("decode the checkpoint %s failed: %w", s.path, err)
+	}
+
+	return checkpoint.NextBlock, true, nil
+}
+
+func (s *FileCheckpointStore) SaveCheckpoint(nextBlock uint64) error {
+	data, err := json.Marshal(fileCheckpoint{NextBlock: nextBlock})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return fmt.Errorf("create the checkpoint dir failed: %w", err)
+	}
+
+	// write to a temp file then rename it, so the checkpoint will not be broken if crashed while writing
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("write the checkpoint %s failed: %w", tmp, err)
+	}
+
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("rename the checkpoint %s failed: %w", tmp, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,370 @@
+package chainio
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/clients/eth"
+	sdklogging "github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+const (
+	defaultIndexerPollInterval = 12 * time.Second
+	// the max count of the watched events waiting to be handled
+	indexerWatchQueueSize = 1024
+)
+
+// IndexerConfig is the config for the `ServiceManagerIndexer`.
+type IndexerConfig struct {
+	// the block to index from if no checkpoint saved, 0 means the head block when started
+	StartBlock uint64
+	// the count of the blocks should be built on top of the block of an event before emitting it,
+	// so the events in the reorged blocks will not be emitted, at least 1.
+	ConfirmationDepth uint64
+	// the max block range for each `Filter*` call
+	BlockRange uint64
+	// the interval to check the head block to emit the confirmed events
+	PollInterval time.Duration
+	// the path of the checkpoint file, if empty, the indexer will start from the `StartBlock` after restart.
+	CheckpointPath string
+}
+
+// ServiceManagerIndexer indexes the events of the MachServiceManager, it backfills the events from the checkpoint
+// by the `Filter*` bindings, then follows the new events by the `Watch*` bindings which needs a ws client.
+// The events are emitted in the order of the chain after confirmed by the `ConfirmationDepth` blocks.
+//
+// Note the reorg deeper than the `ConfirmationDepth` can not be handled, the emitted events will not be reverted.
+type ServiceManagerIndexer struct {
+	cfg        IndexerConfig
+	bindings   *AvsManagersBindings
+	sources    []serviceManagerEventSource
+	checkpoint IndexerCheckpointStore
+	logger     sdklogging.Logger
+
+	feed event.FeedOf[*ServiceManagerEvent]
+
+	// the next block to emit the events, all the events before it had been emitted
+	nextBlock uint64
+	// the events in the blocks not confirmed yet
+	pending map[logKey]*ServiceManagerEvent
+}
+
+// BuildServiceManagerIndexer builds the indexer for the service manager, if the serviceManagerAddr is zero,
+// will use the service manager of the registry coordinator, the ethWsClient should be a ws client to watch the events.
+func BuildServiceManagerIndexer(
+	serviceManagerAddr, registryCoordinatorAddr, operatorStateRetrieverAddr gethcommon.Address,
+	ethWsClient eth.Client, cfg IndexerConfig, logger sdklogging.Logger,
+) (*ServiceManagerIndexer, error) {
+	bindings, err := NewAvsManagersBindingsWithServiceManager(serviceManagerAddr, registryCoordinatorAddr, operatorStateRetrieverAddr, ethWsClient, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewServiceManagerIndexer(bindings, cfg, logger), nil
+}
+
+func NewServiceManagerIndexer(bindings *AvsManagersBindings, cfg IndexerConfig, logger sdklogging.Logger) *ServiceManagerIndexer {
+	if cfg.ConfirmationDepth == 0 {
+		// the logs of the head block may be not all received, so wait for one block at least
+		cfg.ConfirmationDepth = 1
+	}
+	if cfg.BlockRange == 0 {
+		cfg.BlockRange = alertEventsBlockRange
+	}
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = defaultIndexerPollInterval
+	}
+
+	var checkpoint IndexerCheckpointStore
+	if cfg.CheckpointPath != "" {
+		checkpoint = NewFileCheckpointStore(cfg.CheckpointPath)
+	}
+
+	return &ServiceManagerIndexer{
+		cfg:        cfg,
+		bindings:   bindings,
+		sources:    serviceManagerEventSources(bindings.ServiceManager),
+		checkpoint: checkpoint,
+		logger:     logger,
+		pending:    make(map[logKey]*ServiceManagerEvent),
+	}
+}
+
+// Subscribe subscribes the confirmed events, the subscriber should consume the events quickly,
+// or the indexer will be blocked.
+func (i *ServiceManagerIndexer) Subscribe(ch chan<- *ServiceManagerEvent) event.Subscription {
+	return i.feed.Subscribe(ch)
+}
+
+// SubscribeHandlers subscribes the confirmed events by the callbacks, the callbacks are called in one goroutine.
+func (i *ServiceManagerIndexer) SubscribeHandlers(handlers *ServiceManagerEventHandlers) event.Subscription {
+	ch := make(chan *ServiceManagerEvent, indexerWatchQueueSize)
+	sub := i.feed.Subscribe(ch)
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case ev := <-ch:
+				handlers.handle(ev)
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	})
+}
+
+// Start indexes the events until the ctx done, it will resubscribe and backfill the missed events
+// if the subscription failed.
+func (i *ServiceManagerIndexer) Start(ctx context.Context) error {
+	nextBlock := i.cfg.StartBlock
+	if i.checkpoint != nil {
+		checkpoint, found, err := i.checkpoint.LoadCheckpoint()
+		if err != nil {
+			return err
+		}
+		if found && checkpoint > nextBlock {
+			nextBlock = checkpoint
+		}
+	}
+	if nextBlock == 0 {
+		head, err := i.bindings.ethClient.BlockNumber(ctx)
+		if err != nil {
+			return fmt.Errorf("get the head block to start failed: %w", err)
+		}
+		nextBlock = head
+	}
+	i.nextBlock = nextBlock
+
+	i.logger.Info("Start the service manager indexer", "nextBlock", i.nextBlock, "confirmationDepth", i.cfg.ConfirmationDepth)
+
+	for {
+		err := i.run(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		i.logger.Error("The service manager indexer failed, will retry", "err", err, "nextBlock", i.nextBlock)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(i.cfg.PollInterval):
+		}
+	}
+}
+
+// run subscribes the new events, backfills from the next block, then follows the new events.
+func (i *ServiceManagerIndexer) run(ctx context.Context) error {
+	// subscribe before the backfill, so the events after the backfill will not be missed
+	watched := make(chan *ServiceManagerEvent, indexerWatchQueueSize)
+	sub, err := i.watch(ctx, watched)
+	if err != nil {
+		return fmt.Errorf("watch the service manager events failed: %w", err)
+	}
+	defer sub.Unsubscribe()
+
+	// the pending events will be rebuilt by the backfill
+	i.pending = make(map[logKey]*ServiceManagerEvent)
+
+	head, err := i.bindings.ethClient.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("get the head block failed: %w", err)
+	}
+
+	if err := i.backfill(ctx, head); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(i.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-sub.Err():
+			return fmt.Errorf("the subscription of the service manager events failed: %w", err)
+		case ev := <-watched:
+			i.onWatchedEvent(ev)
+		case <-ticker.C:
+			head, err := i.bindings.ethClient.BlockNumber(ctx)
+			if err != nil {
+				i.logger.Warn("Get the head block failed", "err", err)
+				continue
+			}
+
+			if confirmed, ok := i.confirmedBlock(head); ok {
+				i.release(confirmed)
+			}
+		}
+	}
+}
+
+// watch subscribes all the events into the sink.
+func (i *ServiceManagerIndexer) watch(ctx context.Context, sink chan<- *ServiceManagerEvent) (event.Subscription, error) {
+	subs := make([]event.Subscription, 0, len(i.sources))
+	for _, source := range i.sources {
+		sub, err := source.watch(&bind.WatchOpts{Context: ctx}, sink)
+		if err != nil {
+			for _, s := range subs {
+				s.Unsubscribe()
+			}
+			return nil, fmt.Errorf("watch %s failed: %w", source.typ, err)
+		}
+		subs = append(subs, sub)
+	}
+
+	// join the subscriptions, fails if any of them failed
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer func() {
+			for _, s := range subs {
+				s.Unsubscribe()
+			}
+		}()
+
+		errc := make(chan error, len(subs))
+		for _, s := range subs {
+			go func(s event.Subscription) {
+				select {
+				case err := <-s.Err():
+					errc <- err
+				case <-quit:
+				}
+			}(s)
+		}
+
+		select {
+		case err := <-errc:
+			return err
+		case <-quit:
+			return nil
+		}
+	}), nil
+}
+
+// backfill filters the events from the next block to the head, the confirmed events will be emitted,
+// and the others will be pending.
+func (i *ServiceManagerIndexer) backfill(ctx context.Context, head uint64) error {
+	for from := i.nextBlock; from <= head; from += i.cfg.BlockRange {
+		to := from + i.cfg.BlockRange - 1
+		if to > head {
+			to = head
+		}
+
+		events, err := i.filter(ctx, from, to)
+		if err != nil {
+			return err
+		}
+
+		for _, ev := range events {
+			i.pending[ev.key()] = ev
+		}
+
+		// the events after `to` are not filtered yet
+		if confirmed, ok := i.confirmedBlock(head); ok {
+			if confirmed > to {
+				confirmed = to
+			}
+			i.release(confirmed)
+		}
+
+		i.logger.Debug("Backfilled the service manager events", "from", from, "to", to, "events", len(events))
+	}
+
+	return nil
+}
+
+// filter returns the events in the block range in the order of the chain.
+func (i *ServiceManagerIndexer) filter(ctx context.Context, from, to uint64) ([]*ServiceManagerEvent, error) {
+	var res []*ServiceManagerEvent
+	for _, source := range i.sources {
+		events, err := source.filter(&bind.FilterOpts{
+			Start:   from,
+			End:     &to,
+			Context: ctx,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("filter %s in [%d, %d] failed: %w", source.typ, from, to, err)
+		}
+		res = append(res, events...)
+	}
+
+	sortEvents(res)
+
+	return res, nil
+}
+
+// onWatchedEvent adds the new event to the pending, or removes the event in the reorged block.
+func (i *ServiceManagerIndexer) onWatchedEvent(ev *ServiceManagerEvent) {
+	if ev.Raw.BlockNumber < i.nextBlock {
+		if ev.Raw.Removed {
+			i.logger.Error("The emitted event is reorged, the confirmation depth is too small", "event", ev, "blockHash", ev.Raw.BlockHash)
+		}
+		return
+	}
+
+	if ev.Raw.Removed {
+		i.logger.Warn("The pending event is reorged", "event", ev, "blockHash", ev.Raw.BlockHash)
+		delete(i.pending, ev.key())
+		return
+	}
+
+	i.pending[ev.key()] = ev
+}
+
+// confirmedBlock returns the latest block confirmed by the head.
+func (i *ServiceManagerIndexer) confirmedBlock(head uint64) (uint64, bool) {
+	if head < i.cfg.ConfirmationDepth {
+		return 0, false
+	}
+	return head - i.cfg.ConfirmationDepth, true
+}
+
+// release emits the pending events up to the confirmed block in the order of the chain, and saves the checkpoint.
+func (i *ServiceManagerIndexer) release(confirmed uint64) {
+	if confirmed < i.nextBlock {
+		return
+	}
+
+	events := make([]*ServiceManagerEvent, 0, len(i.pending))
+	for key, ev := range i.pending {
+		if ev.Raw.BlockNumber <= confirmed {
+			events = append(events, ev)
+			delete(i.pending, key)
+		}
+	}
+
+	sortEvents(events)
+
+	for _, ev := range events {
+		i.logger.Debug("Emit the service manager event", "event", ev, "txHash", ev.Raw.TxHash)
+		i.feed.Send(ev)
+	}
+
+	i.nextBlock = confirmed + 1
+
+	if i.checkpoint != nil {
+		if err := i.checkpoint.SaveCheckpoint(i.nextBlock); err != nil {
+			i.logger.Error("Save the indexer checkpoint failed", "err", err, "nextBlock", i.nextBlock)
+		}
+	}
+}
+
+// sortEvents sorts the events in the order of the chain.
+func sortEvents(events []*ServiceManagerEvent) {
+	sort.Slice(events, func(a, b int) bool {
+		if events[a].Raw.BlockNumber != events[b].Raw.BlockNumber {
+			return events[a].Raw.BlockNumber < events[b].Raw.BlockNumber
+		}
+		return events[a].Raw.Index < events[b].Raw.Index
+	})
+}
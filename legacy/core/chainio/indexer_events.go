@@ -0,0 +1,296 @@
+package chainio
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+
+	csservicemanager "github.com/alt-research/avs/contracts/bindings/MachServiceManager"
+)
+
+// ServiceManagerEventType is the type of the MachServiceManager events indexed.
+type ServiceManagerEventType string
+
+const (
+	EventAlertConfirmed                   ServiceManagerEventType = "AlertConfirmed"
+	EventAlertRemoved                     ServiceManagerEventType = "AlertRemoved"
+	EventOperatorAllowed                  ServiceManagerEventType = "OperatorAllowed"
+	EventOperatorDisallowed               ServiceManagerEventType = "OperatorDisallowed"
+	EventAllowlistEnabled                 ServiceManagerEventType = "AllowlistEnabled"
+	EventAllowlistDisabled                ServiceManagerEventType = "AllowlistDisabled"
+	EventQuorumThresholdPercentageChanged ServiceManagerEventType = "QuorumThresholdPercentageChanged"
+	EventRollupChainIDUpdated             ServiceManagerEventType = "RollupChainIDUpdated"
+)
+
+// ServiceManagerEvent is an event of the MachServiceManager, only the field for the `Type` is set.
+type ServiceManagerEvent struct {
+	Type ServiceManagerEventType
+	// the log of the event
+	Raw types.Log
+
+	AlertConfirmed                   *csservicemanager.ContractMachServiceManagerAlertConfirmed
+	AlertRemoved                     *csservicemanager.ContractMachServiceManagerAlertRemoved
+	OperatorAllowed                  *csservicemanager.ContractMachServiceManagerOperatorAllowed
+	OperatorDisallowed               *csservicemanager.ContractMachServiceManagerOperatorDisallowed
+	AllowlistEnabled                 *csservicemanager.ContractMachServiceManagerAllowlistEnabled
+	AllowlistDisabled                *csservicemanager.ContractMachServiceManagerAllowlistDisabled
+	QuorumThresholdPercentageChanged *csservicemanager.ContractMachServiceManagerQuorumThresholdPercentageChanged
+	RollupChainIDUpdated             *csservicemanager.ContractMachServiceManagerRollupChainIDUpdated
+}
+
+// logKey is the key of the log in the chain, the logs in the reorged block will have a different key.
+type logKey struct {
+	blockHash [32]byte
+	index     uint
+}
+
+func (e *ServiceManagerEvent) key() logKey {
+	return logKey{blockHash: e.Raw.BlockHash, index: e.Raw.Index}
+}
+
+// String returns the event type with its position in the chain.
+func (e *ServiceManagerEvent) String() string {
+	return fmt.Sprintf("%s@%d:%d", e.Type, e.Raw.BlockNumber, e.Raw.Index)
+}
+
+// ServiceManagerEventHandlers is the callbacks for the MachServiceManager events, the nil callback will be skipped.
+type ServiceManagerEventHandlers struct {
+	OnAlertConfirmed                   func(ev *csservicemanager.ContractMachServiceManagerAlertConfirmed)
+	OnAlertRemoved                     func(ev *csservicemanager.ContractMachServiceManagerAlertRemoved)
+	OnOperatorAllowed                  func(ev *csservicemanager.ContractMachServiceManagerOperatorAllowed)
+	OnOperatorDisallowed               func(ev *csservicemanager.ContractMachServiceManagerOperatorDisallowed)
+	OnAllowlistEnabled                 func(ev *csservicemanager.ContractMachServiceManagerAllowlistEnabled)
+	OnAllowlistDisabled                func(ev *csservicemanager.ContractMachServiceManagerAllowlistDisabled)
+	OnQuorumThresholdPercentageChanged func(ev *csservicemanager.ContractMachServiceManagerQuorumThresholdPercentageChanged)
+	OnRollupChainIDUpdated             func(ev *csservicemanager.ContractMachServiceManagerRollupChainIDUpdated)
+}
+
+// handle calls the callback for the event.
+func (h *ServiceManagerEventHandlers) handle(ev *ServiceManagerEvent) {
+	switch ev.Type {
+	case EventAlertConfirmed:
+		if h.OnAlertConfirmed != nil {
+			h.OnAlertConfirmed(ev.AlertConfirmed)
+		}
+	case EventAlertRemoved:
+		if h.OnAlertRemoved != nil {
+			h.OnAlertRemoved(ev.AlertRemoved)
+		}
+	case EventOperatorAllowed:
+		if h.OnOperatorAllowed != nil {
+			h.OnOperatorAllowed(ev.OperatorAllowed)
+		}
+	case EventOperatorDisallowed:
+		if h.OnOperatorDisallowed != nil {
+			h.OnOperatorDisallowed(ev.OperatorDisallowed)
+		}
+	case EventAllowlistEnabled:
+		if h.OnAllowlistEnabled != nil {
+			h.OnAllowlistEnabled(ev.AllowlistEnabled)
+		}
+	case EventAllowlistDisabled:
+		if h.OnAllowlistDisabled != nil {
+			h.OnAllowlistDisabled(ev.AllowlistDisabled)
+		}
+	case EventQuorumThresholdPercentageChanged:
+		if h.OnQuorumThresholdPercentageChanged != nil {
+			h.OnQuorumThresholdPercentageChanged(ev.QuorumThresholdPercentageChanged)
+		}
+	case EventRollupChainIDUpdated:
+		if h.OnRollupChainIDUpdated != nil {
+			h.OnRollupChainIDUpdated(ev.RollupChainIDUpdated)
+		}
+	}
+}
+
+// eventIterator is the iterator generated by abigen for the `Filter*` bindings.
+type eventIterator interface {
+	Next() bool
+	Error() error
+	Close() error
+}
+
+// serviceManagerEventSource is the `Filter*` and `Watch*` bindings for an event type.
+type serviceManagerEventSource struct {
+	typ    ServiceManagerEventType
+	filter func(opts *bind.FilterOpts) ([]*ServiceManagerEvent, error)
+	watch  func(opts *bind.WatchOpts, sink chan<- *ServiceManagerEvent) (event.Subscription, error)
+}
+
+// filterEvents collects all the events from the iterator.
+func filterEvents[I eventIterator](it I, err error, current func(it I) *ServiceManagerEvent) ([]*ServiceManagerEvent, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var res []*ServiceManagerEvent
+	for it.Next() {
+		res = append(res, current(it))
+	}
+
+	return res, it.Error()
+}
+
+// watchEvents forwards the events from the typed sink to the sink of the service manager events.
+func watchEvents[E any](sink chan<- *ServiceManagerEvent, wrap func(ev E) *ServiceManagerEvent, watch func(ch chan<- E) (event.Subscription, error)) (event.Subscription, error) {
+	ch := make(chan E)
+	sub, err := watch(ch)
+	if err != nil {
+		return nil, err
+	}
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case ev := <-ch:
+				select {
+				case sink <- wrap(ev):
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// serviceManagerEventSources returns the sources of all the indexed events.
+func serviceManagerEventSources(sm *csservicemanager.ContractMachServiceManager) []serviceManagerEventSource {
+	return []serviceManagerEventSource{
+		{
+			typ: EventAlertConfirmed,
+			filter: func(opts *bind.FilterOpts) ([]*ServiceManagerEvent, error) {
+				it, err := sm.FilterAlertConfirmed(opts, nil)
+				return filterEvents(it, err, func(it *csservicemanager.ContractMachServiceManagerAlertConfirmedIterator) *ServiceManagerEvent {
+					return &ServiceManagerEvent{Type: EventAlertConfirmed, Raw: it.Event.Raw, AlertConfirmed: it.Event}
+				})
+			},
+			watch: func(opts *bind.WatchOpts, sink chan<- *ServiceManagerEvent) (event.Subscription, error) {
+				return watchEvents(sink, func(ev *csservicemanager.ContractMachServiceManagerAlertConfirmed) *ServiceManagerEvent {
+					return &ServiceManagerEvent{Type: EventAlertConfirmed, Raw: ev.Raw, AlertConfirmed: ev}
+				}, func(ch chan<- *csservicemanager.ContractMachServiceManagerAlertConfirmed) (event.Subscription, error) {
+					return sm.WatchAlertConfirmed(opts, ch, nil)
+				})
+			},
+		},
+		{
+			typ: EventAlertRemoved,
+			filter: func(opts *bind.FilterOpts) ([]*ServiceManagerEvent, error) {
+				it, err := sm.FilterAlertRemoved(opts)
+				return filterEvents(it, err, func(it *csservicemanager.ContractMachServiceManagerAlertRemovedIterator) *ServiceManagerEvent {
+					return &ServiceManagerEvent{Type: EventAlertRemoved, Raw: it.Event.Raw, AlertRemoved: it.Event}
+				})
+			},
+			watch: func(opts *bind.WatchOpts, sink chan<- *ServiceManagerEvent) (event.Subscription, error) {
+				return watchEvents(sink, func(ev *csservicemanager.ContractMachServiceManagerAlertRemoved) *ServiceManagerEvent {
+					return &ServiceManagerEvent{Type: EventAlertRemoved, Raw: ev.Raw, AlertRemoved: ev}
+				}, func(ch chan<- *csservicemanager.ContractMachServiceManagerAlertRemoved) (event.Subscription, error) {
+					return sm.WatchAlertRemoved(opts, ch)
+				})
+			},
+		},
+		{
+			typ: EventOperatorAllowed,
+			filter: func(opts *bind.FilterOpts) ([]*ServiceManagerEvent, error) {
+				it, err := sm.FilterOperatorAllowed(opts)
+				return filterEvents(it, err, func(it *csservicemanager.ContractMachServiceManagerOperatorAllowedIterator) *ServiceManagerEvent {
+					return &ServiceManagerEvent{Type: EventOperatorAllowed, Raw: it.Event.Raw, OperatorAllowed: it.Event}
+				})
+			},
+			watch: func(opts *bind.WatchOpts, sink chan<- *ServiceManagerEvent) (event.Subscription, error) {
+				return watchEvents(sink, func(ev *csservicemanager.ContractMachServiceManagerOperatorAllowed) *ServiceManagerEvent {
+					return &ServiceManagerEvent{Type: EventOperatorAllowed, Raw: ev.Raw, OperatorAllowed: ev}
+				}, func(ch chan<- *csservicemanager.ContractMachServiceManagerOperatorAllowed) (event.Subscription, error) {
+					return sm.WatchOperatorAllowed(opts, ch)
+				})
+			},
+		},
+		{
+			typ: EventOperatorDisallowed,
+			filter: func(opts *bind.FilterOpts) ([]*ServiceManagerEvent, error) {
+				it, err := sm.FilterOperatorDisallowed(opts)
+				return filterEvents(it, err, func(it *csservicemanager.ContractMachServiceManagerOperatorDisallowedIterator) *ServiceManagerEvent {
+					return &ServiceManagerEvent{Type: EventOperatorDisallowed, Raw: it.Event.Raw, OperatorDisallowed: it.Event}
+				})
+			},
+			watch: func(opts *bind.WatchOpts, sink chan<- *ServiceManagerEvent) (event.Subscription, error) {
+				return watchEvents(sink, func(ev *csservicemanager.ContractMachServiceManagerOperatorDisallowed) *ServiceManagerEvent {
+					return &ServiceManagerEvent{Type: EventOperatorDisallowed, Raw: ev.Raw, OperatorDisallowed: ev}
+				}, func(ch chan<- *csservicemanager.ContractMachServiceManagerOperatorDisallowed) (event.Subscription, error) {
+					return sm.WatchOperatorDisallowed(opts, ch)
+				})
+			},
+		},
+		{
+			typ: EventAllowlistEnabled,
+			filter: func(opts *bind.FilterOpts) ([]*ServiceManagerEvent, error) {
+				it, err := sm.FilterAllowlistEnabled(opts)
+				return filterEvents(it, err, func(it *csservicemanager.ContractMachServiceManagerAllowlistEnabledIterator) *ServiceManagerEvent {
+					return &ServiceManagerEvent{Type: EventAllowlistEnabled, Raw: it.Event.Raw, AllowlistEnabled: it.Event}
+				})
+			},
+			watch: func(opts *bind.WatchOpts, sink chan<- *ServiceManagerEvent) (event.Subscription, error) {
+				return watchEvents(sink, func(ev *csservicemanager.ContractMachServiceManagerAllowlistEnabled) *ServiceManagerEvent {
+					return &ServiceManagerEvent{Type: EventAllowlistEnabled, Raw: ev.Raw, AllowlistEnabled: ev}
+				}, func(ch chan<- *csservicemanager.ContractMachServiceManagerAllowlistEnabled) (event.Subscription, error) {
+					return sm.WatchAllowlistEnabled(opts, ch)
+				})
+			},
+		},
+		{
+			typ: EventAllowlistDisabled,
+			filter: func(opts *bind.FilterOpts) ([]*ServiceManagerEvent, error) {
+				it, err := sm.FilterAllowlistDisabled(opts)
+				return filterEvents(it, err, func(it *csservicemanager.ContractMachServiceManagerAllowlistDisabledIterator) *ServiceManagerEvent {
+					return &ServiceManagerEvent{Type: EventAllowlistDisabled, Raw: it.Event.Raw, AllowlistDisabled: it.Event}
+				})
+			},
+			watch: func(opts *bind.WatchOpts, sink chan<- *ServiceManagerEvent) (event.Subscription, error) {
+				return watchEvents(sink, func(ev *csservicemanager.ContractMachServiceManagerAllowlistDisabled) *ServiceManagerEvent {
+					return &ServiceManagerEvent{Type: EventAllowlistDisabled, Raw: ev.Raw, AllowlistDisabled: ev}
+				}, func(ch chan<- *csservicemanager.ContractMachServiceManagerAllowlistDisabled) (event.Subscription, error) {
+					return sm.WatchAllowlistDisabled(opts, ch)
+				})
+			},
+		},
+		{
+			typ: EventQuorumThresholdPercentageChanged,
+			filter: func(opts *bind.FilterOpts) ([]*ServiceManagerEvent, error) {
+				it, err := sm.FilterQuorumThresholdPercentageChanged(opts)
+				return filterEvents(it, err, func(it *csservicemanager.ContractMachServiceManagerQuorumThresholdPercentageChangedIterator) *ServiceManagerEvent {
+					return &ServiceManagerEvent{Type: EventQuorumThresholdPercentageChanged, Raw: it.Event.Raw, QuorumThresholdPercentageChanged: it.Event}
+				})
+			},
+			watch: func(opts *bind.WatchOpts, sink chan<- *ServiceManagerEvent) (event.Subscription, error) {
+				return watchEvents(sink, func(ev *csservicemanager.ContractMachServiceManagerQuorumThresholdPercentageChanged) *ServiceManagerEvent {
+					return &ServiceManagerEvent{Type: EventQuorumThresholdPercentageChanged, Raw: ev.Raw, QuorumThresholdPercentageChanged: ev}
+				}, func(ch chan<- *csservicemanager.ContractMachServiceManagerQuorumThresholdPercentageChanged) (event.Subscription, error) {
+					return sm.WatchQuorumThresholdPercentageChanged(opts, ch)
+				})
+			},
+		},
+		{
+			typ: EventRollupChainIDUpdated,
+			filter: func(opts *bind.FilterOpts) ([]*ServiceManagerEvent, error) {
+				it, err := sm.FilterRollupChainIDUpdated(opts)
+				return filterEvents(it, err, func(it *csservicemanager.ContractMachServiceManagerRollupChainIDUpdatedIterator) *ServiceManagerEvent {
+					return &ServiceManagerEvent{Type: EventRollupChainIDUpdated, Raw: it.Event.Raw, RollupChainIDUpdated: it.Event}
+				})
+			},
+			watch: func(opts *bind.WatchOpts, sink chan<- *ServiceManagerEvent) (event.Subscription, error) {
+				return watchEvents(sink, func(ev *csservicemanager.ContractMachServiceManagerRollupChainIDUpdated) *ServiceManagerEvent {
+					return &ServiceManagerEvent{Type: EventRollupChainIDUpdated, Raw: ev.Raw, RollupChainIDUpdated: ev}
+				}, func(ch chan<- *csservicemanager.ContractMachServiceManagerRollupChainIDUpdated) (event.Subscription, error) {
+					return sm.WatchRollupChainIDUpdated(opts, ch)
+				})
+			},
+		},
+	}
+}
@@ -17,6 +17,7 @@ import (
 	contractOperatorStateRetriever "github.com/Layr-Labs/eigensdk-go/contracts/bindings/OperatorStateRetriever"
 	types "github.com/Layr-Labs/eigensdk-go/types"
 	contractMachServiceManager "github.com/alt-research/avs/contracts/bindings/MachServiceManager"
+	chainio "github.com/alt-research/avs/legacy/core/chainio"
 	bind "github.com/ethereum/go-ethereum/accounts/abi/bind"
 	common "github.com/ethereum/go-ethereum/common"
 	gomock "go.uber.org/mock/gomock"
@@ -60,6 +61,35 @@ func (mr *MockAvsReadererMockRecorder) CheckSignatures(arg0, arg1, arg2, arg3, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSignatures", reflect.TypeOf((*MockAvsReaderer)(nil).CheckSignatures), arg0, arg1, arg2, arg3, arg4)
 }
 
+// CountAlerts mocks base method.
+func (m *MockAvsReaderer) CountAlerts(arg0 context.Context, arg1 uint32) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAlerts", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAlerts indicates an expected call of CountAlerts.
+func (mr *MockAvsReadererMockRecorder) CountAlerts(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAlerts", reflect.TypeOf((*MockAvsReaderer)(nil).CountAlerts), arg0, arg1)
+}
+
+// FindAlertConfirmedEvents mocks base method.
+func (m *MockAvsReaderer) FindAlertConfirmedEvents(arg0 context.Context, arg1 uint64, arg2 []*chainio.ConfirmedAlert) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAlertConfirmedEvents", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindAlertConfirmedEvents indicates an expected call of FindAlertConfirmedEvents.
+func (mr *MockAvsReadererMockRecorder) FindAlertConfirmedEvents(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAlertConfirmedEvents", reflect.TypeOf((*MockAvsReaderer)(nil).FindAlertConfirmedEvents), arg0, arg1, arg2)
+}
+
 // GetCheckSignaturesIndices mocks base method.
 func (m *MockAvsReaderer) GetCheckSignaturesIndices(arg0 *bind.CallOpts, arg1 uint32, arg2 types.QuorumNums, arg3 []types.Bytes32) (contractOperatorStateRetriever.OperatorStateRetrieverCheckSignaturesIndices, error) {
 	m.ctrl.T.Helper()
@@ -213,10 +243,10 @@ func (mr *MockAvsReadererMockRecorder) GetQuorumCount(arg0 any) *gomock.Call {
 }
 
 // GetQuorumThresholdPercentages mocks base method.
-func (m *MockAvsReaderer) GetQuorumThresholdPercentages(arg0 context.Context, arg1 uint32, arg2 []types.QuorumNum) ([]types.QuorumThresholdPercentage, error) {
+func (m *MockAvsReaderer) GetQuorumThresholdPercentages(arg0 context.Context, arg1 uint32, arg2 types.QuorumNums) (types.QuorumThresholdPercentages, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetQuorumThresholdPercentages", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]types.QuorumThresholdPercentage)
+	ret0, _ := ret[0].(types.QuorumThresholdPercentages)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -228,10 +258,10 @@ func (mr *MockAvsReadererMockRecorder) GetQuorumThresholdPercentages(arg0, arg1,
 }
 
 // GetQuorumsByBlockNumber mocks base method.
-func (m *MockAvsReaderer) GetQuorumsByBlockNumber(arg0 context.Context, arg1 uint32) ([]types.QuorumNum, error) {
+func (m *MockAvsReaderer) GetQuorumsByBlockNumber(arg0 context.Context, arg1 uint32) (types.QuorumNums, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetQuorumsByBlockNumber", arg0, arg1)
-	ret0, _ := ret[0].([]types.QuorumNum)
+	ret0, _ := ret[0].(types.QuorumNums)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -242,6 +272,21 @@ func (mr *MockAvsReadererMockRecorder) GetQuorumsByBlockNumber(arg0, arg1 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuorumsByBlockNumber", reflect.TypeOf((*MockAvsReaderer)(nil).GetQuorumsByBlockNumber), arg0, arg1)
 }
 
+// IsAlertContains mocks base method.
+func (m *MockAvsReaderer) IsAlertContains(arg0 context.Context, arg1 uint32, arg2 [32]byte) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAlertContains", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAlertContains indicates an expected call of IsAlertContains.
+func (mr *MockAvsReadererMockRecorder) IsAlertContains(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAlertContains", reflect.TypeOf((*MockAvsReaderer)(nil).IsAlertContains), arg0, arg1, arg2)
+}
+
 // IsOperatorRegistered mocks base method.
 func (m *MockAvsReaderer) IsOperatorRegistered(arg0 *bind.CallOpts, arg1 common.Address) (bool, error) {
 	m.ctrl.T.Helper()
@@ -257,6 +302,36 @@ func (mr *MockAvsReadererMockRecorder) IsOperatorRegistered(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOperatorRegistered", reflect.TypeOf((*MockAvsReaderer)(nil).IsOperatorRegistered), arg0, arg1)
 }
 
+// IsRollupChainIdValid mocks base method.
+func (m *MockAvsReaderer) IsRollupChainIdValid(arg0 context.Context, arg1 uint32) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRollupChainIdValid", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRollupChainIdValid indicates an expected call of IsRollupChainIdValid.
+func (mr *MockAvsReadererMockRecorder) IsRollupChainIdValid(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRollupChainIdValid", reflect.TypeOf((*MockAvsReaderer)(nil).IsRollupChainIdValid), arg0, arg1)
+}
+
+// ListConfirmedAlerts mocks base method.
+func (m *MockAvsReaderer) ListConfirmedAlerts(arg0 context.Context, arg1 uint32, arg2, arg3 uint64) ([]*chainio.ConfirmedAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConfirmedAlerts", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*chainio.ConfirmedAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConfirmedAlerts indicates an expected call of ListConfirmedAlerts.
+func (mr *MockAvsReadererMockRecorder) ListConfirmedAlerts(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConfirmedAlerts", reflect.TypeOf((*MockAvsReaderer)(nil).ListConfirmedAlerts), arg0, arg1, arg2, arg3)
+}
+
 // QueryExistingRegisteredOperatorPubKeys mocks base method.
 func (m *MockAvsReaderer) QueryExistingRegisteredOperatorPubKeys(arg0 context.Context, arg1, arg2 *big.Int) ([]common.Address, []types.OperatorPubkeys, error) {
 	m.ctrl.T.Helper()
@@ -272,3 +347,33 @@ func (mr *MockAvsReadererMockRecorder) QueryExistingRegisteredOperatorPubKeys(ar
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryExistingRegisteredOperatorPubKeys", reflect.TypeOf((*MockAvsReaderer)(nil).QueryExistingRegisteredOperatorPubKeys), arg0, arg1, arg2)
 }
+
+// QueryExistingRegisteredOperatorSockets mocks base method.
+func (m *MockAvsReaderer) QueryExistingRegisteredOperatorSockets(arg0 context.Context, arg1, arg2 *big.Int) (map[types.Bytes32]types.Socket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryExistingRegisteredOperatorSockets", arg0, arg1, arg2)
+	ret0, _ := ret[0].(map[types.Bytes32]types.Socket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryExistingRegisteredOperatorSockets indicates an expected call of QueryExistingRegisteredOperatorSockets.
+func (mr *MockAvsReadererMockRecorder) QueryExistingRegisteredOperatorSockets(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryExistingRegisteredOperatorSockets", reflect.TypeOf((*MockAvsReaderer)(nil).QueryExistingRegisteredOperatorSockets), arg0, arg1, arg2)
+}
+
+// QueryMessageHashes mocks base method.
+func (m *MockAvsReaderer) QueryMessageHashes(arg0 context.Context, arg1 uint32, arg2, arg3 *big.Int) ([][32]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryMessageHashes", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([][32]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryMessageHashes indicates an expected call of QueryMessageHashes.
+func (mr *MockAvsReadererMockRecorder) QueryMessageHashes(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryMessageHashes", reflect.TypeOf((*MockAvsReaderer)(nil).QueryMessageHashes), arg0, arg1, arg2, arg3)
+}
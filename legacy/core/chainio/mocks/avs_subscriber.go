@@ -40,12 +40,27 @@ func (m *MockAvsSubscriberer) EXPECT() *MockAvsSubscribererMockRecorder {
 	return m.recorder
 }
 
+// ResubscribeToAlertConfirmed mocks base method.
+func (m *MockAvsSubscriberer) ResubscribeToAlertConfirmed(arg0 uint64, arg1 chan<- *contractMachServiceManager.ContractMachServiceManagerAlertConfirmed) event.Subscription {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResubscribeToAlertConfirmed", arg0, arg1)
+	ret0, _ := ret[0].(event.Subscription)
+	return ret0
+}
+
+// ResubscribeToAlertConfirmed indicates an expected call of ResubscribeToAlertConfirmed.
+func (mr *MockAvsSubscribererMockRecorder) ResubscribeToAlertConfirmed(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResubscribeToAlertConfirmed", reflect.TypeOf((*MockAvsSubscriberer)(nil).ResubscribeToAlertConfirmed), arg0, arg1)
+}
+
 // SubscribeToAlertConfirmed mocks base method.
-func (m *MockAvsSubscriberer) SubscribeToAlertConfirmed(arg0 chan *contractMachServiceManager.ContractMachServiceManagerAlertConfirmed) event.Subscription {
+func (m *MockAvsSubscriberer) SubscribeToAlertConfirmed(arg0 chan *contractMachServiceManager.ContractMachServiceManagerAlertConfirmed) (event.Subscription, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SubscribeToAlertConfirmed", arg0)
 	ret0, _ := ret[0].(event.Subscription)
-	return ret0
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // SubscribeToAlertConfirmed indicates an expected call of SubscribeToAlertConfirmed.
@@ -48,6 +48,21 @@ func (m *MockAvsWriterer) EXPECT() *MockAvsWritererMockRecorder {
 	return m.recorder
 }
 
+// BuildConfirmAlertTx mocks base method.
+func (m *MockAvsWriterer) BuildConfirmAlertTx(arg0 context.Context, arg1 *message.AlertTaskInfo, arg2 contractMachServiceManager.IBLSSignatureCheckerNonSignerStakesAndSignature) (*types0.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildConfirmAlertTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types0.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildConfirmAlertTx indicates an expected call of BuildConfirmAlertTx.
+func (mr *MockAvsWritererMockRecorder) BuildConfirmAlertTx(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildConfirmAlertTx", reflect.TypeOf((*MockAvsWriterer)(nil).BuildConfirmAlertTx), arg0, arg1, arg2)
+}
+
 // DeregisterOperator mocks base method.
 func (m *MockAvsWriterer) DeregisterOperator(arg0 context.Context, arg1 types.QuorumNums, arg2 contractRegistryCoordinator.BN254G1Point) (*types0.Receipt, error) {
 	m.ctrl.T.Helper()
@@ -93,6 +108,21 @@ func (mr *MockAvsWritererMockRecorder) SendConfirmAlert(arg0, arg1, arg2 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendConfirmAlert", reflect.TypeOf((*MockAvsWriterer)(nil).SendConfirmAlert), arg0, arg1, arg2)
 }
 
+// SendRemoveAlert mocks base method.
+func (m *MockAvsWriterer) SendRemoveAlert(arg0 context.Context, arg1 uint32, arg2 [32]byte) (*types0.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendRemoveAlert", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types0.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendRemoveAlert indicates an expected call of SendRemoveAlert.
+func (mr *MockAvsWritererMockRecorder) SendRemoveAlert(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRemoveAlert", reflect.TypeOf((*MockAvsWriterer)(nil).SendRemoveAlert), arg0, arg1, arg2)
+}
+
 // UpdateStakesOfEntireOperatorSetForQuorums mocks base method.
 func (m *MockAvsWriterer) UpdateStakesOfEntireOperatorSetForQuorums(arg0 context.Context, arg1 [][]common.Address, arg2 types.QuorumNums) (*types0.Receipt, error) {
 	m.ctrl.T.Helper()
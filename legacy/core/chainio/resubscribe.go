@@ -0,0 +1,177 @@
+package chainio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/event"
+
+	sdklogging "github.com/Layr-Labs/eigensdk-go/logging"
+
+	csservicemanager "github.com/alt-research/avs/contracts/bindings/MachServiceManager"
+)
+
+// the max backoff to resubscribe after the subscription failed, the backoff starts from 1/10 of it.
+const resubscribeBackoffMax = time.Minute
+
+var errSubscriptionClosed = errors.New("the subscription closed")
+
+// alertConfirmedStream delivers the AlertConfirmed events in the order of the chain across the resubscriptions,
+// the missed events while disconnected are backfilled by `FilterAlertConfirmed`.
+type alertConfirmedStream struct {
+	bindings *AvsManagersBindings
+	logger   sdklogging.Logger
+	sink     chan<- *csservicemanager.ContractMachServiceManagerAlertConfirmed
+
+	// the block to backfill from after resubscribed, the events in it may have been delivered,
+	// 0 means to start from the head block at the first subscription.
+	cursor uint64
+	// the delivered events in the cursor block, to dedupe the events delivered by both the backfill and the subscription
+	seen map[logKey]struct{}
+}
+
+// ResubscribeToAlertConfirmed subscribes the AlertConfirmed events from the fromBlock, 0 means from the head block.
+// The subscription will be resubscribed with backoff if failed, and the events missed while disconnected will be backfilled,
+// so the events are delivered in the order of the chain without duplicates until unsubscribed.
+// The logs removed by reorg are delivered with `Raw.Removed` set.
+func (s *AvsSubscriber) ResubscribeToAlertConfirmed(fromBlock uint64, alertConfirmedChan chan<- *csservicemanager.ContractMachServiceManagerAlertConfirmed) event.Subscription {
+	stream := &alertConfirmedStream{
+		bindings: s.AvsContractBindings,
+		logger:   s.logger,
+		sink:     alertConfirmedChan,
+		cursor:   fromBlock,
+		seen:     make(map[logKey]struct{}),
+	}
+
+	return event.ResubscribeErr(resubscribeBackoffMax, stream.subscribe)
+}
+
+// subscribe watches the new events, then backfills the events from the cursor to the head,
+// the returned subscription delivers the watched events until failed.
+func (s *alertConfirmedStream) subscribe(ctx context.Context, lastErr error) (event.Subscription, error) {
+	if lastErr != nil {
+		s.logger.Warn("The AlertConfirmed subscription failed, resubscribe", "err", lastErr, "cursor", s.cursor)
+	}
+
+	// subscribe before the backfill, so the events after the backfill will not be missed
+	watched := make(chan *csservicemanager.ContractMachServiceManagerAlertConfirmed, indexerWatchQueueSize)
+	sub, err := s.bindings.ServiceManager.WatchAlertConfirmed(&bind.WatchOpts{Context: ctx}, watched, nil)
+	if err != nil {
+		s.logger.Error("Failed to subscribe to AlertConfirmed events", "err", err)
+		return nil, err
+	}
+
+	if err := s.backfill(ctx); err != nil {
+		sub.Unsubscribe()
+		s.logger.Error("Failed to backfill AlertConfirmed events", "err", err, "cursor", s.cursor)
+		return nil, err
+	}
+
+	s.logger.Info("Subscribed to AlertConfirmed events", "cursor", s.cursor)
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case ev := <-watched:
+				if !s.deliver(ev, quit) {
+					return nil
+				}
+			case err := <-sub.Err():
+				if err == nil {
+					err = errSubscriptionClosed
+				}
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// backfill delivers the events from the cursor to the head block.
+func (s *alertConfirmedStream) backfill(ctx context.Context) error {
+	head, err := s.bindings.ethClient.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+
+	if s.cursor == 0 {
+		s.cursor = head
+	}
+
+	for from := s.cursor; from <= head; from += alertEventsBlockRange {
+		to := from + alertEventsBlockRange - 1
+		if to > head {
+			to = head
+		}
+
+		it, err := s.bindings.ServiceManager.FilterAlertConfirmed(&bind.FilterOpts{
+			Start:   from,
+			End:     &to,
+			Context: ctx,
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("filter the alert confirmed events in [%d, %d] failed: %w", from, to, err)
+		}
+
+		for it.Next() {
+			if !s.deliver(it.Event, ctx.Done()) {
+				it.Close()
+				return ctx.Err()
+			}
+		}
+		err = it.Error()
+		it.Close()
+		if err != nil {
+			return fmt.Errorf("iterate the alert confirmed events in [%d, %d] failed: %w", from, to, err)
+		}
+
+		// all the events to `to` had been delivered
+		s.advance(to)
+	}
+
+	return nil
+}
+
+// deliver sends the event to the sink if not delivered, returns false if quit.
+func (s *alertConfirmedStream) deliver(ev *csservicemanager.ContractMachServiceManagerAlertConfirmed, quit <-chan struct{}) bool {
+	key := logKey{blockHash: ev.Raw.BlockHash, index: ev.Raw.Index}
+
+	if ev.Raw.Removed {
+		delete(s.seen, key)
+	} else {
+		if ev.Raw.BlockNumber < s.cursor {
+			return true
+		}
+		if _, ok := s.seen[key]; ok {
+			return true
+		}
+	}
+
+	select {
+	case s.sink <- ev:
+	case <-quit:
+		return false
+	}
+
+	if !ev.Raw.Removed {
+		s.advance(ev.Raw.BlockNumber)
+		s.seen[key] = struct{}{}
+	}
+
+	return true
+}
+
+// advance moves the cursor to the block, the seen events before it will be dropped.
+func (s *alertConfirmedStream) advance(block uint64) {
+	if block <= s.cursor {
+		return
+	}
+
+	s.cursor = block
+	s.seen = make(map[logKey]struct{})
+}
@@ -0,0 +1,199 @@
+package chainio
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/clients/eth"
+	sdklogging "github.com/Layr-Labs/eigensdk-go/logging"
+	"github.com/ethereum/go-ethereum"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	csservicemanager "github.com/alt-research/avs/contracts/bindings/MachServiceManager"
+)
+
+// fakeLogSubscription is the ws subscription of the logs, the test drops it by sending the error.
+type fakeLogSubscription struct {
+	logs chan<- gethtypes.Log
+	err  chan error
+}
+
+func (s *fakeLogSubscription) Unsubscribe() {}
+
+func (s *fakeLogSubscription) Err() <-chan error {
+	return s.err
+}
+
+// fakeLogsChain keeps the logs of the chain, the new subscriptions are sent to the subs.
+type fakeLogsChain struct {
+	eth.Client
+
+	mu   sync.Mutex
+	head uint64
+	logs []gethtypes.Log
+	// the logs delivered by the next subscription once subscribed, which may also be backfilled
+	watched []gethtypes.Log
+
+	subs chan *fakeLogSubscription
+}
+
+func (c *fakeLogsChain) BlockNumber(ctx context.Context) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.head, nil
+}
+
+func (c *fakeLogsChain) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]gethtypes.Log, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res := make([]gethtypes.Log, 0)
+	for _, log := range c.logs {
+		if log.BlockNumber >= q.FromBlock.Uint64() && log.BlockNumber <= q.ToBlock.Uint64() {
+			res = append(res, log)
+		}
+	}
+
+	return res, nil
+}
+
+func (c *fakeLogsChain) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- gethtypes.Log) (ethereum.Subscription, error) {
+	c.mu.Lock()
+	watched := c.watched
+	c.watched = nil
+	c.mu.Unlock()
+
+	for _, log := range watched {
+		ch <- log
+	}
+
+	sub := &fakeLogSubscription{logs: ch, err: make(chan error, 1)}
+	c.subs <- sub
+
+	return sub, nil
+}
+
+// mine adds the logs to the chain and moves the head.
+func (c *fakeLogsChain) mine(head uint64, logs ...gethtypes.Log) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.head = head
+	c.logs = append(c.logs, logs...)
+}
+
+func newAlertConfirmedLog(t *testing.T, blockNumber uint64, index uint, messageHash [32]byte) gethtypes.Log {
+	t.Helper()
+
+	serviceManagerAbi, err := csservicemanager.ContractMachServiceManagerMetaData.GetAbi()
+	if err != nil {
+		t.Fatalf("get the service manager abi failed: %v", err)
+	}
+
+	blockHash := gethcommon.BigToHash(new(big.Int).SetUint64(blockNumber))
+	return gethtypes.Log{
+		Topics:      []gethcommon.Hash{serviceManagerAbi.Events["AlertConfirmed"].ID, gethcommon.Hash(messageHash)},
+		Data:        messageHash[:],
+		BlockNumber: blockNumber,
+		BlockHash:   blockHash,
+		Index:       index,
+	}
+}
+
+func TestResubscribeToAlertConfirmed(t *testing.T) {
+	var (
+		a = newAlertConfirmedLog(t, 100, 0, [32]byte{1})
+		b = newAlertConfirmedLog(t, 101, 0, [32]byte{2})
+		c = newAlertConfirmedLog(t, 101, 1, [32]byte{3})
+		d = newAlertConfirmedLog(t, 102, 0, [32]byte{4})
+		e = newAlertConfirmedLog(t, 103, 0, [32]byte{5})
+	)
+
+	chain := &fakeLogsChain{
+		head: 100,
+		logs: []gethtypes.Log{a},
+		subs: make(chan *fakeLogSubscription, 1),
+	}
+
+	serviceManager, err := csservicemanager.NewContractMachServiceManager(gethcommon.HexToAddress("0x01"), chain)
+	if err != nil {
+		t.Fatalf("create the service manager binding failed: %v", err)
+	}
+	subscriber := NewAvsSubscriber(&AvsManagersBindings{
+		ServiceManager: serviceManager,
+		ethClient:      chain,
+		logger:         sdklogging.NewNoopLogger(),
+	}, sdklogging.NewNoopLogger())
+
+	sink := make(chan *csservicemanager.ContractMachServiceManagerAlertConfirmed, 16)
+	sub := subscriber.ResubscribeToAlertConfirmed(100, sink)
+	defer sub.Unsubscribe()
+
+	waitSub := func() *fakeLogSubscription {
+		select {
+		case s := <-chain.subs:
+			return s
+		case <-time.After(time.Second):
+			t.Fatalf("the stream should subscribe the logs")
+			return nil
+		}
+	}
+	expect := func(logs ...gethtypes.Log) {
+		t.Helper()
+
+		for _, log := range logs {
+			select {
+			case ev := <-sink:
+				if ev.Raw.BlockNumber != log.BlockNumber || ev.Raw.Index != log.Index {
+					t.Fatalf("expect the event at block %d index %d, got block %d index %d",
+						log.BlockNumber, log.Index, ev.Raw.BlockNumber, ev.Raw.Index)
+				}
+				if ev.MessageHash != [32]byte(log.Data) {
+					t.Fatalf("expect the message hash %x, got %x", log.Data, ev.MessageHash)
+				}
+			case <-time.After(time.Second):
+				t.Fatalf("expect the event at block %d index %d", log.BlockNumber, log.Index)
+			}
+		}
+	}
+
+	// the event at the from block is backfilled
+	sub1 := waitSub()
+	expect(a)
+
+	// the watched event at the cursor block which had been backfilled is deduped by the seen
+	sub1.logs <- a
+	sub1.logs <- b
+	expect(b)
+
+	// the ws dropped while the events mined, the new subscription watches the event also backfilled
+	chain.mine(102, c, d)
+	chain.mu.Lock()
+	chain.watched = []gethtypes.Log{d}
+	chain.mu.Unlock()
+	sub1.err <- errors.New("websocket: close 1006 (abnormal closure)")
+
+	// the backfill from the cursor block 101 skips the delivered b
+	sub2 := waitSub()
+	expect(c, d)
+
+	// the events before the cursor or seen at the cursor block are not delivered again
+	sub2.logs <- c
+	sub2.logs <- d
+	chain.mine(103, e)
+	sub2.logs <- e
+	expect(e)
+
+	sub.Unsubscribe()
+	select {
+	case ev := <-sink:
+		t.Fatalf("the event at block %d index %d delivered more than once", ev.Raw.BlockNumber, ev.Raw.Index)
+	default:
+	}
+}
@@ -0,0 +1,112 @@
+package chainio
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// the custom errors in `contracts/src/error/Errors.sol` which had no args, the abi of the bindings
+// not contains the errors, so the list should be updated from `Errors.sol` when the errors changed.
+var machServiceManagerErrorNames = []string{
+	"ZeroAddress",
+	"InvalidStartIndex",
+	"InvalidConfirmer",
+	"NotWhitelister",
+	"InvalidSender",
+	"NoStatusChange",
+	"InvalidRollupChainID",
+	"InvalidReferenceBlockNum",
+	"InsufficientThreshold",
+	"InsufficientThresholdPercentages",
+	"InvalidQuorumParam",
+	"InvalidQuorumThresholdPercentage",
+	"AlreadyInAllowlist",
+	"NotAdded",
+	"AlreadyAdded",
+	"ResolvedAlert",
+	"AlreadyEnabled",
+	"AlreadyDisabled",
+	"AlreadyInitialized",
+	"NotInitialized",
+	"ZeroValue",
+	"UselessAlert",
+	"InvalidAlert",
+	"InvalidAlertType",
+	"InvalidProvedIndex",
+	"InvalidCheckpoint",
+	"InvalidIndex",
+	"ProveImageIdMismatch",
+	"ProveBlockNumberMismatch",
+	"ProveOutputRootMismatch",
+	"ParentCheckpointNumberMismatch",
+	"ParentCheckpointOutputRootMismatch",
+	"ProveVerifyFailed",
+	"InvalidJournal",
+	"NoAlert",
+	"NotOperator",
+}
+
+var machServiceManagerErrors = func() map[[4]byte]string {
+	res := make(map[[4]byte]string, len(machServiceManagerErrorNames))
+	for _, name := range machServiceManagerErrorNames {
+		var selector [4]byte
+		copy(selector[:], crypto.Keccak256([]byte(name + "()"))[:4])
+		res[selector] = name
+	}
+	return res
+}()
+
+// IsRevertError returns whether the error is the call reverted with the revert data, the other errors
+// such as the transport errors or the timeouts may be fixed by retrying.
+func IsRevertError(err error) bool {
+	var dataErr interface{ ErrorData() interface{} }
+	return errors.As(err, &dataErr) && dataErr.ErrorData() != nil
+}
+
+// DecodeRevertReason decodes the revert reason from the error returned by eth_call or eth_estimateGas,
+// it supports the `Error(string)`, `Panic(uint256)` and the custom errors in mach contracts,
+// if the error not contains the revert data, will return the error message.
+func DecodeRevertReason(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var dataErr interface{ ErrorData() interface{} }
+	if !errors.As(err, &dataErr) {
+		return err.Error()
+	}
+
+	var data []byte
+	switch v := dataErr.ErrorData().(type) {
+	case string:
+		decoded, decodeErr := hexutil.Decode(v)
+		if decodeErr != nil {
+			return err.Error()
+		}
+		data = decoded
+	case []byte:
+		data = v
+	default:
+		return err.Error()
+	}
+
+	if reason, unpackErr := abi.UnpackRevert(data); unpackErr == nil {
+		return reason
+	}
+
+	if len(data) >= 4 {
+		var selector [4]byte
+		copy(selector[:], data[:4])
+		if name, ok := machServiceManagerErrors[selector]; ok {
+			return name
+		}
+	}
+
+	return fmt.Sprintf("%s: %s", strings.TrimSpace(err.Error()), common.Bytes2Hex(data))
+}
@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 type NodeConfig struct {
 	// used to set the logger level (true = info, false = debug)
 	Production                        bool   `yaml:"production"`
@@ -22,4 +24,76 @@ type NodeConfig struct {
 	OperatorSocket                    string `yaml:"operator_socket"`
 	Layer1ChainId                     uint32 `yaml:"layer1_chain_id"`
 	Layer2ChainId                     uint32 `yaml:"layer2_chain_id"`
+	// the max time to wait the confirm alert tx submitted by aggregator after the signature accepted,
+	// so the alert response can carry the tx hash, 0 means not wait.
+	WaitTaskSubmittedTimeout time.Duration `yaml:"wait_task_submitted_timeout"`
+	// the url of the verifier to POST the lifecycle events of the alerts signed by the operator,
+	// such as the alert confirmed or expired, empty means not send.
+	AlertWebhookUrl string `yaml:"alert_webhook_url"`
+	// the count of the blocks after the reference block that the alert expired if not confirmed,
+	// should be the aggregator 's `task_challenge_window_block`, 0 means use the default 100.
+	AlertExpiredBlocks uint64 `yaml:"alert_expired_blocks"`
+	// the min count of the blocks built on top of the reference block of the task before signing it,
+	// 0 means only check the reference block is not in the future.
+	ReferenceBlockConfirmations uint64 `yaml:"reference_block_confirmations"`
+	// the rpc url of a trusted op-node, if set, the output root alerts will be validated by the output roots
+	// it derived by `optimism_outputAtBlock` before signing.
+	OpNodeRpcUrl string `yaml:"op_node_rpc_url"`
+	// the `L2OutputOracleProxy` address in layer1, used to validate the `alert_blockOutputOracleMismatch`,
+	// if not set, the alert will not be validated.
+	L2OutputOracleAddress string `yaml:"l2_output_oracle_address"`
+	// the max count of the alerts processed concurrently, 0 means use the default 8.
+	AlertWorkers int `yaml:"alert_workers"`
+	// the max time to process an alert, from creating the task to the aggregator accepted the signature,
+	// 0 means use 1 minute plus the `wait_task_submitted_timeout`.
+	AlertTimeout time.Duration `yaml:"alert_timeout"`
+	// the path of the pebble db to keep the signed task responses until accepted by aggregator,
+	// so they can be resent after restart, empty means keep them in memory.
+	OutboxPath string `yaml:"outbox_path"`
+	// the file to keep the block indexed for the service manager events, so the events during the operator
+	// stopped can be backfilled after restart, empty means start from the `indexer_start_block` each time.
+	IndexerCheckpointPath string `yaml:"indexer_checkpoint_path"`
+	// the block to index the service manager events from if no checkpoint, 0 means the head block when started.
+	IndexerStartBlock uint64 `yaml:"indexer_start_block"`
+	// the count of the blocks built on top of an event before handling it, 0 means use the default 1.
+	IndexerConfirmationDepth uint64 `yaml:"indexer_confirmation_depth"`
+	// the aggregators in priority order, the first is the primary, the others are standby which used
+	// when the prior ones unreachable, if set, the `aggregator_*_ip_port_address` will be ignored.
+	AggregatorEndpoints []AggregatorEndpoint `yaml:"aggregator_endpoints"`
+	// the interval to probe the aggregator endpoints, 0 means use the default 10s.
+	AggregatorHealthCheckInterval time.Duration `yaml:"aggregator_health_check_interval"`
+	// the timeout of each request to the aggregator by grpc or jsonrpc, 0 means use the default 1s.
+	AggregatorRequestTimeout time.Duration `yaml:"aggregator_request_timeout"`
+	// the interval to ping the aggregator grpc server if no activity, 0 means use the default 30s.
+	AggregatorGRPCKeepaliveInterval time.Duration `yaml:"aggregator_grpc_keepalive_interval"`
+	// the TLS to connect to the aggregator grpc server, not use TLS if disabled.
+	AggregatorGRPCTLS AggregatorGRPCTLSConfig `yaml:"aggregator_grpc_tls"`
+}
+
+// AggregatorGRPCTLSConfig is the TLS config to connect to the aggregator grpc server.
+type AggregatorGRPCTLSConfig struct {
+	Enable bool `yaml:"enable"`
+	// the CA certificate to verify the aggregator, empty means use the system CAs
+	CaPath string `yaml:"ca_path"`
+	// the certificate and key of the operator for mTLS, both empty means no client certificate
+	CertPath string `yaml:"cert_path"`
+	KeyPath  string `yaml:"key_path"`
+	// the name to verify the aggregator certificate, empty means use the host of the address
+	ServerName string `yaml:"server_name"`
+}
+
+// The transports to connect to the aggregator.
+const (
+	AggregatorTransportJSONRPC = "jsonrpc"
+	AggregatorTransportGRPC    = "grpc"
+	// the legacy net/rpc server
+	AggregatorTransportRPC = "rpc"
+)
+
+// AggregatorEndpoint is an aggregator with the transport to connect to it.
+type AggregatorEndpoint struct {
+	// one of `jsonrpc`, `grpc` and `rpc`
+	Transport string `yaml:"transport"`
+	// the url for `jsonrpc`, such as `http://localhost:8290`, or the `ip:port` for the others
+	Address string `yaml:"address"`
 }
@@ -23,12 +23,19 @@ import (
 	"github.com/alt-research/avs/legacy/core"
 )
 
+const (
+	// the default number of blocks after which a task is considered expired
+	defaultTaskChallengeWindowBlock  = 100
+	defaultEigenMetricsIpPortAddress = ":9090"
+)
+
 // Config contains all of the configuration information for a mach aggregators and challengers.
 // Operators use a separate config. (see config-files/operator.anvil.yaml)
 type Config struct {
 	BlsPrivateKey             *bls.PrivateKey
 	Logger                    sdklogging.Logger
 	EigenMetricsIpPortAddress string
+	EnableMetrics             bool
 	// we need the url for the eigensdk currently... eventually standardize api so as to
 	// only take an ethclient or an rpcUrl (and build the ethclient at each constructor site)
 	EthHttpRpcUrl                     string
@@ -42,9 +49,17 @@ type Config struct {
 	AggregatorJSONRPCServerIpPortAddr string
 	Layer1ChainId                     uint32
 	Layer2ChainId                     uint32
+	Rollups                           []*RollupConfig
 	RpcVhosts                         []string
 	RpcCors                           []string
 	QuorumNums                        types.QuorumNums
+	TaskStorePath                     string
+	IndexerCheckpointPath             string
+	IndexerStartBlock                 uint64
+	IndexerConfirmationDepth          uint64
+	TaskChallengeWindowBlock          uint64
+	ConfirmAlertPolicy                ConfirmAlertPolicy
+	GRPCServer                        GRPCServerConfig
 	// json:"-" skips this field when marshaling (only used for logging to stdout), since SignerFn doesnt implement marshalJson
 	SignerFn          signerv2.SignerFn `json:"-"`
 	PrivateKey        *ecdsa.PrivateKey `json:"-"`
@@ -63,8 +78,18 @@ type ConfigRaw struct {
 	Layer1ChainId                     uint32              `yaml:"layer1_chain_id"`
 	Layer2ChainId                     uint32              `yaml:"layer2_chain_id"`
 	QuorumNums                        []uint8             `yaml:"quorum_nums"`
+	Rollups                           []RollupConfigRaw   `yaml:"rollups"`
 	RpcVhosts                         []string            `yaml:"rpc_vhosts"`
 	RpcCors                           []string            `yaml:"rpc_cors"`
+	TaskStorePath                     string              `yaml:"task_store_path"`
+	IndexerCheckpointPath             string              `yaml:"indexer_checkpoint_path"`
+	IndexerStartBlock                 uint64              `yaml:"indexer_start_block"`
+	IndexerConfirmationDepth          uint64              `yaml:"indexer_confirmation_depth"`
+	TaskChallengeWindowBlock          uint64              `yaml:"task_challenge_window_block"`
+	EigenMetricsIpPortAddress         string              `yaml:"eigen_metrics_ip_port_address"`
+	EnableMetrics                     bool                `yaml:"enable_metrics"`
+	ConfirmAlertPolicy                ConfirmAlertPolicy  `yaml:"confirm_alert_policy"`
+	GRPCServer                        GRPCServerConfig    `yaml:"grpc_server"`
 }
 
 // These are read from DeploymentFileFlag
@@ -112,6 +137,26 @@ func NewConfig(ctx *cli.Context) (*Config, error) {
 		configRaw.AggregatorJSONRPCServerIpPortAddr = aggregatorJSONRPCServerIpPortAddr
 	}
 
+	taskStorePath, ok := os.LookupEnv("TASK_STORE_PATH")
+	if ok && taskStorePath != "" {
+		configRaw.TaskStorePath = taskStorePath
+	}
+
+	indexerCheckpointPath, ok := os.LookupEnv("INDEXER_CHECKPOINT_PATH")
+	if ok && indexerCheckpointPath != "" {
+		configRaw.IndexerCheckpointPath = indexerCheckpointPath
+	}
+
+	eigenMetricsIpPortAddress, ok := os.LookupEnv("EIGEN_METRICS_URL")
+	if ok && eigenMetricsIpPortAddress != "" {
+		configRaw.EigenMetricsIpPortAddress = eigenMetricsIpPortAddress
+	}
+
+	enableMetrics, ok := os.LookupEnv("ENABLE_METRICS")
+	if ok && enableMetrics != "" {
+		configRaw.EnableMetrics = enableMetrics == "true"
+	}
+
 	var deploymentRaw MachAvsDeploymentRaw
 
 	avsRegistryCoordinatorAddress, rcOk := os.LookupEnv("AVS_REGISTRY_COORDINATOR_ADDRESS")
@@ -211,8 +256,37 @@ func NewConfig(ctx *cli.Context) (*Config, error) {
 		"raw", fmt.Sprintf("%#v", configRaw.QuorumNums),
 	)
 
+	rollups, err := newRollupConfigs(configRaw.Rollups, configRaw.Layer2ChainId, quorumNums)
+	if err != nil {
+		logger.Error("Cannot parse the rollups config", "err", err)
+		return nil, err
+	}
+	if len(configRaw.Rollups) != 0 && configRaw.Layer2ChainId == 0 {
+		// the first rollup is the default one if the layer2_chain_id not set
+		configRaw.Layer2ChainId = rollups[0].ChainId
+	}
+	for _, rollup := range rollups {
+		logger.Info(
+			"the rollup served by aggregator",
+			"chainId", rollup.ChainId,
+			"serviceManager", rollup.ServiceManagerAddr.Hex(),
+			"quorumNums", fmt.Sprintf("%#v", rollup.QuorumNums),
+		)
+	}
+
+	if configRaw.TaskChallengeWindowBlock == 0 {
+		logger.Warn("not task_challenge_window_block, just use default", "default", defaultTaskChallengeWindowBlock)
+		configRaw.TaskChallengeWindowBlock = defaultTaskChallengeWindowBlock
+	}
+
+	if configRaw.EigenMetricsIpPortAddress == "" {
+		configRaw.EigenMetricsIpPortAddress = defaultEigenMetricsIpPortAddress
+	}
+
 	config := &Config{
 		Logger:                            logger,
+		EigenMetricsIpPortAddress:         configRaw.EigenMetricsIpPortAddress,
+		EnableMetrics:                     configRaw.EnableMetrics,
 		EthWsRpcUrl:                       configRaw.EthWsUrl,
 		EthHttpRpcUrl:                     configRaw.EthRpcUrl,
 		EthHttpClient:                     ethRpcClient,
@@ -228,9 +302,17 @@ func NewConfig(ctx *cli.Context) (*Config, error) {
 		AggregatorAddress:                 aggregatorAddr,
 		Layer1ChainId:                     configRaw.Layer1ChainId,
 		Layer2ChainId:                     configRaw.Layer2ChainId,
+		Rollups:                           rollups,
 		QuorumNums:                        quorumNums,
 		RpcVhosts:                         configRaw.RpcVhosts,
 		RpcCors:                           configRaw.RpcCors,
+		TaskStorePath:                     configRaw.TaskStorePath,
+		IndexerCheckpointPath:             configRaw.IndexerCheckpointPath,
+		IndexerStartBlock:                 configRaw.IndexerStartBlock,
+		IndexerConfirmationDepth:          configRaw.IndexerConfirmationDepth,
+		TaskChallengeWindowBlock:          configRaw.TaskChallengeWindowBlock,
+		ConfirmAlertPolicy:                configRaw.ConfirmAlertPolicy.WithDefaults(),
+		GRPCServer:                        configRaw.GRPCServer.WithDefaults(),
 	}
 	config.validate()
 	return config, nil
@@ -244,6 +326,12 @@ func (c *Config) validate() {
 	if c.RegistryCoordinatorAddr == common.HexToAddress("") {
 		panic("Config: RegistryCoordinatorAddr is required")
 	}
+	if c.GetRollup(c.Layer2ChainId) == nil {
+		panic("Config: Layer2ChainId should be one of the rollups")
+	}
+	if err := c.GRPCServer.TLS.validate(); err != nil {
+		panic(fmt.Sprintf("Config: grpc_server.tls %v", err))
+	}
 }
 
 var (
@@ -0,0 +1,115 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// GRPCServerConfig is the config for the aggregator gRPC server which exposed to the operators.
+type GRPCServerConfig struct {
+	TLS GRPCServerTLSConfig `yaml:"tls"`
+	// register the gRPC reflection service, so the tools like grpcurl can list the methods
+	EnableReflection bool `yaml:"enable_reflection"`
+	// the min interval of the keepalive pings the clients can send, the connections ping faster will be closed,
+	// should be less than the `aggregator_grpc_keepalive_interval` of the operators
+	KeepaliveMinTime time.Duration `yaml:"keepalive_min_time"`
+	// the server pings the client after the connection idle for the time
+	KeepaliveTime time.Duration `yaml:"keepalive_time"`
+	// the time to wait the ping ack before closing the connection
+	KeepaliveTimeout time.Duration `yaml:"keepalive_timeout"`
+	// the time to wait the pending requests finished when stopping, after that the connections will be closed
+	DrainTimeout time.Duration `yaml:"drain_timeout"`
+}
+
+// GRPCServerTLSConfig is the TLS config of the aggregator gRPC server, the server is insecure if the cert not set.
+type GRPCServerTLSConfig struct {
+	CertPath string `yaml:"cert_path"`
+	KeyPath  string `yaml:"key_path"`
+	// the ca to verify the client certificates, the mTLS is required if set
+	ClientCaPath string `yaml:"client_ca_path"`
+	// pin the client certificates for the operators, the operator address to the sha256 fingerprints (hex) of its
+	// certificates, if set, only the operators listed can call the methods with the operator auth.
+	OperatorCerts map[string][]string `yaml:"operator_certs"`
+}
+
+const (
+	defaultGRPCServerKeepaliveMinTime = 10 * time.Second
+	defaultGRPCServerKeepaliveTime    = 1 * time.Minute
+	defaultGRPCServerKeepaliveTimeout = 20 * time.Second
+	defaultGRPCServerDrainTimeout     = 10 * time.Second
+)
+
+// WithDefaults returns the config which use the default value for the unset fields.
+func (c GRPCServerConfig) WithDefaults() GRPCServerConfig {
+	if c.KeepaliveMinTime <= 0 {
+		c.KeepaliveMinTime = defaultGRPCServerKeepaliveMinTime
+	}
+
+	if c.KeepaliveTime <= 0 {
+		c.KeepaliveTime = defaultGRPCServerKeepaliveTime
+	}
+
+	if c.KeepaliveTimeout <= 0 {
+		c.KeepaliveTimeout = defaultGRPCServerKeepaliveTimeout
+	}
+
+	if c.DrainTimeout <= 0 {
+		c.DrainTimeout = defaultGRPCServerDrainTimeout
+	}
+
+	return c
+}
+
+// Enabled returns if the TLS is enabled for the server.
+func (c GRPCServerTLSConfig) Enabled() bool {
+	return c.CertPath != ""
+}
+
+// OperatorCertFingerprints returns the pinned certificate fingerprints by the operator address,
+// the fingerprints are lower case hex without the `0x` prefix and colons.
+func (c GRPCServerTLSConfig) OperatorCertFingerprints() (map[common.Address][]string, error) {
+	if len(c.OperatorCerts) == 0 {
+		return nil, nil
+	}
+
+	if c.ClientCaPath == "" {
+		return nil, fmt.Errorf("the client_ca_path is required to pin the operator certificates")
+	}
+
+	res := make(map[common.Address][]string, len(c.OperatorCerts))
+	for addr, fingerprints := range c.OperatorCerts {
+		if !common.IsHexAddress(addr) {
+			return nil, fmt.Errorf("invalid operator address %s in operator_certs", addr)
+		}
+
+		address := common.HexToAddress(addr)
+		for _, fingerprint := range fingerprints {
+			fingerprint = strings.ToLower(strings.ReplaceAll(strings.TrimPrefix(fingerprint, "0x"), ":", ""))
+			if len(fingerprint) != 64 {
+				return nil, fmt.Errorf("invalid certificate fingerprint %s for operator %s, should be the hex of sha256", fingerprint, addr)
+			}
+			res[address] = append(res[address], fingerprint)
+		}
+	}
+
+	return res, nil
+}
+
+func (c GRPCServerTLSConfig) validate() error {
+	if c.CertPath == "" && c.KeyPath == "" {
+		if c.ClientCaPath != "" || len(c.OperatorCerts) != 0 {
+			return fmt.Errorf("the cert_path and key_path are required for the mTLS")
+		}
+		return nil
+	}
+
+	if c.CertPath == "" || c.KeyPath == "" {
+		return fmt.Errorf("the cert_path and key_path should be both set")
+	}
+
+	_, err := c.OperatorCertFingerprints()
+	return err
+}
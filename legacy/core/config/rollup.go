@@ -0,0 +1,86 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/Layr-Labs/eigensdk-go/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// RollupConfigRaw is the config for a rollup chain served by the aggregator.
+type RollupConfigRaw struct {
+	// the rollup chain id, which is the `rollupChainID` in the alert header
+	ChainId uint32 `yaml:"chain_id"`
+	// the MachServiceManager address for the rollup, if not set,
+	// will use the service manager of the registry coordinator.
+	ServiceManagerAddress string `yaml:"service_manager_address"`
+	// the quorum nums for the tasks of the rollup, if not set, will use the global `quorum_nums`
+	QuorumNums []uint8 `yaml:"quorum_nums"`
+}
+
+// RollupConfig is the config for a rollup chain served by the aggregator,
+// each rollup has its own service manager, quorums and tasks.
+type RollupConfig struct {
+	ChainId            uint32
+	ServiceManagerAddr common.Address
+	QuorumNums         types.QuorumNums
+}
+
+// newRollupConfigs returns the rollups config, if no rollups configured, will use the `layer2_chain_id`
+// and `quorum_nums` as the only rollup.
+func newRollupConfigs(raws []RollupConfigRaw, layer2ChainId uint32, quorumNums types.QuorumNums) ([]*RollupConfig, error) {
+	if len(raws) == 0 {
+		return []*RollupConfig{{
+			ChainId:    layer2ChainId,
+			QuorumNums: quorumNums,
+		}}, nil
+	}
+
+	res := make([]*RollupConfig, 0, len(raws))
+	seen := make(map[uint32]struct{}, len(raws))
+	for _, raw := range raws {
+		if _, ok := seen[raw.ChainId]; ok {
+			return nil, fmt.Errorf("the rollup chain id %d is duplicated", raw.ChainId)
+		}
+		seen[raw.ChainId] = struct{}{}
+
+		rollup := &RollupConfig{
+			ChainId:    raw.ChainId,
+			QuorumNums: quorumNums,
+		}
+
+		if raw.ServiceManagerAddress != "" {
+			if !common.IsHexAddress(raw.ServiceManagerAddress) {
+				return nil, fmt.Errorf("the service manager address %s of rollup %d is invalid", raw.ServiceManagerAddress, raw.ChainId)
+			}
+			rollup.ServiceManagerAddr = common.HexToAddress(raw.ServiceManagerAddress)
+		}
+
+		if len(raw.QuorumNums) != 0 {
+			rollup.QuorumNums = make(types.QuorumNums, len(raw.QuorumNums))
+			for i, quorumNum := range raw.QuorumNums {
+				rollup.QuorumNums[i] = types.QuorumNum(quorumNum)
+			}
+		}
+
+		res = append(res, rollup)
+	}
+
+	return res, nil
+}
+
+// GetRollup returns the config for the rollup chain id, 0 means the default rollup `Layer2ChainId`,
+// returns nil if the rollup not served by the aggregator.
+func (c *Config) GetRollup(chainId uint32) *RollupConfig {
+	if chainId == 0 {
+		chainId = c.Layer2ChainId
+	}
+
+	for _, rollup := range c.Rollups {
+		if rollup.ChainId == chainId {
+			return rollup
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"time"
+)
+
+// ConfirmAlertPolicy is the retry and fee policy for the aggregator to send the confirm alert txs.
+type ConfirmAlertPolicy struct {
+	// the max times to retry when send the confirm alert failed, not set means use the default, 0 means no retry
+	MaxRetries *int `yaml:"max_retries"`
+	// the backoff interval for retry, will be doubled for each retry until the max retry interval
+	RetryInterval    time.Duration `yaml:"retry_interval"`
+	MaxRetryInterval time.Duration `yaml:"max_retry_interval"`
+	// if the tx not be mined after the resubmit timeout, will replace it with bumped fee
+	ResubmitTimeout time.Duration `yaml:"resubmit_timeout"`
+	// the max times to bump fee for a tx, after that will return failed and retry
+	MaxFeeBumps int `yaml:"max_fee_bumps"`
+	// the percent to bump the fee, should be >= 10 for the replacement
+	FeeBumpPercent uint64 `yaml:"fee_bump_percent"`
+	// the max gas fee cap in gwei, 0 means no limit
+	MaxGasFeeCapGwei   uint64  `yaml:"max_gas_fee_cap_gwei"`
+	GasLimitMultiplier float64 `yaml:"gas_limit_multiplier"`
+}
+
+const (
+	defaultConfirmAlertMaxRetries         = 5
+	defaultConfirmAlertRetryInterval      = 6 * time.Second
+	defaultConfirmAlertMaxRetryInterval   = 1 * time.Minute
+	defaultConfirmAlertResubmitTimeout    = 36 * time.Second
+	defaultConfirmAlertMaxFeeBumps        = 3
+	defaultConfirmAlertFeeBumpPercent     = 20
+	minConfirmAlertFeeBumpPercent         = 10
+	defaultConfirmAlertGasLimitMultiplier = 1.2
+)
+
+// WithDefaults returns the policy which use the default value for the unset fields.
+func (p ConfirmAlertPolicy) WithDefaults() ConfirmAlertPolicy {
+	if p.MaxRetries == nil || *p.MaxRetries < 0 {
+		maxRetries := defaultConfirmAlertMaxRetries
+		p.MaxRetries = &maxRetries
+	}
+
+	if p.RetryInterval <= 0 {
+		p.RetryInterval = defaultConfirmAlertRetryInterval
+	}
+
+	if p.MaxRetryInterval < p.RetryInterval {
+		p.MaxRetryInterval = defaultConfirmAlertMaxRetryInterval
+		if p.MaxRetryInterval < p.RetryInterval {
+			p.MaxRetryInterval = p.RetryInterval
+		}
+	}
+
+	if p.ResubmitTimeout <= 0 {
+		p.ResubmitTimeout = defaultConfirmAlertResubmitTimeout
+	}
+
+	if p.MaxFeeBumps <= 0 {
+		p.MaxFeeBumps = defaultConfirmAlertMaxFeeBumps
+	}
+
+	if p.FeeBumpPercent == 0 {
+		p.FeeBumpPercent = defaultConfirmAlertFeeBumpPercent
+	} else if p.FeeBumpPercent < minConfirmAlertFeeBumpPercent {
+		// the node will reject the replacement tx if the fee bump less than 10%
+		p.FeeBumpPercent = minConfirmAlertFeeBumpPercent
+	}
+
+	if p.GasLimitMultiplier < 1 {
+		p.GasLimitMultiplier = defaultConfirmAlertGasLimitMultiplier
+	}
+
+	return p
+}
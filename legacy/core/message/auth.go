@@ -0,0 +1,120 @@
+package message
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/Layr-Labs/eigensdk-go/crypto/bls"
+	sdktypes "github.com/Layr-Labs/eigensdk-go/types"
+	"github.com/alt-research/avs/legacy/api/grpc/aggregator"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// The methods which need the operator auth
+const (
+	AuthMethodInitOperator              = "InitOperator"
+	AuthMethodCreateTask                = "CreateTask"
+	AuthMethodProcessSignedTaskResponse = "ProcessSignedTaskResponse"
+)
+
+// the domain of the operator auth digest, to avoid the signature reused by other messages.
+var operatorAuthDomain = []byte("mach-avs/aggregator/operator-auth")
+
+// The auth of operator for the requests to aggregator
+type OperatorAuth struct {
+	OperatorId sdktypes.OperatorId
+	// the unix time when the request signed
+	Timestamp int64
+	Signature bls.Signature
+}
+
+// NewOperatorAuth signs the request payload for the method by the operator 's BLS key.
+func NewOperatorAuth(keypair *bls.KeyPair, operatorId sdktypes.OperatorId, method string, payload [32]byte) *OperatorAuth {
+	res := &OperatorAuth{
+		OperatorId: operatorId,
+		Timestamp:  time.Now().Unix(),
+	}
+
+	res.Signature = *keypair.SignMessage(res.Digest(method, payload))
+
+	return res
+}
+
+// NewOperatorAuthFromPb returns nil if the auth not exist in request.
+func NewOperatorAuthFromPb(req *aggregator.OperatorAuth) (*OperatorAuth, error) {
+	if req == nil {
+		return nil, nil
+	}
+
+	operatorId := req.GetOperatorId()
+	if len(operatorId) != 32 {
+		return nil, fmt.Errorf("auth operator ID len should be 32, got %d", len(operatorId))
+	}
+
+	signRaw := req.GetSignature()
+	if len(signRaw) != 64 {
+		return nil, fmt.Errorf("auth signature len should be 64")
+	}
+
+	res := &OperatorAuth{
+		Timestamp: req.GetTimestamp(),
+		Signature: bls.Signature{G1Point: bls.NewZeroG1Point().Deserialize(signRaw)},
+	}
+
+	copy(res.OperatorId[:], operatorId[:32])
+
+	return res, nil
+}
+
+func (a *OperatorAuth) ToPbType() *aggregator.OperatorAuth {
+	if a == nil {
+		return nil
+	}
+
+	return &aggregator.OperatorAuth{
+		OperatorId: a.OperatorId[:],
+		Timestamp:  a.Timestamp,
+		Signature:  a.Signature.Serialize(),
+	}
+}
+
+// Digest returns the hash to sign for the auth:
+//
+//	keccak256(domain || method || operatorId || timestamp || payload)
+func (a *OperatorAuth) Digest(method string, payload [32]byte) [32]byte {
+	return crypto.Keccak256Hash(
+		operatorAuthDomain,
+		[]byte(method),
+		a.OperatorId[:],
+		binary.BigEndian.AppendUint64(nil, uint64(a.Timestamp)),
+		payload[:],
+	)
+}
+
+// AuthPayload returns the hash of the request for the operator auth.
+func (r InitOperatorRequest) AuthPayload() [32]byte {
+	return crypto.Keccak256Hash(
+		binary.BigEndian.AppendUint32(nil, r.Layer1ChainId),
+		binary.BigEndian.AppendUint32(nil, r.ChainId),
+		r.OperatorId[:],
+		r.OperatorAddress.Bytes(),
+		r.OperatorStateRetrieverAddr.Bytes(),
+		r.RegistryCoordinatorAddr.Bytes(),
+	)
+}
+
+// AuthPayload returns the hash of the request for the operator auth.
+func (r CreateTaskRequest) AuthPayload() [32]byte {
+	return crypto.Keccak256Hash(r.AlertHash[:], binary.BigEndian.AppendUint32(nil, r.RollupChainId))
+}
+
+// AuthPayload returns the hash of the request for the operator auth.
+func (r SignedTaskRespRequest) AuthPayload() ([32]byte, error) {
+	hash, err := r.Alert.SignHash()
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	return crypto.Keccak256Hash(hash[:], r.BlsSignature.Serialize(), r.OperatorId[:]), nil
+}
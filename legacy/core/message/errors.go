@@ -0,0 +1,107 @@
+package message
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// The error code returned by aggregator, the operator can act on it, the codes are in [1000, 2000).
+type AggregatorErrorCode uint32
+
+const (
+	// the request not signed by a registered operator
+	ErrCodeUnauthorized AggregatorErrorCode = 1001
+	// the operator had not called `InitOperator` to aggregator
+	ErrCodeOperatorNotInitialized AggregatorErrorCode = 1002
+	// the operator not registered in the task quorums at the reference block, or had been ejected
+	ErrCodeOperatorNotRegistered AggregatorErrorCode = 1003
+	// the task not exist in aggregator
+	ErrCodeTaskNotFound AggregatorErrorCode = 1004
+	// the signed alert not match the task stored in aggregator
+	ErrCodeTaskMismatch AggregatorErrorCode = 1005
+	// the task had expired or failed, can not accept signature
+	ErrCodeTaskTerminated AggregatorErrorCode = 1006
+	// the BLS signature can not be verified by the operator 's pubkeys
+	ErrCodeInvalidSignature AggregatorErrorCode = 1007
+	// the operator had signed the task
+	ErrCodeDuplicateSignature AggregatorErrorCode = 1008
+	// the alert had been confirmed in AVS
+	ErrCodeTaskFinished AggregatorErrorCode = 1009
+	// the rollup chain id not served by the aggregator
+	ErrCodeRollupNotSupported AggregatorErrorCode = 1010
+)
+
+var aggregatorErrorCodeNames = map[AggregatorErrorCode]string{
+	ErrCodeUnauthorized:           "Unauthorized",
+	ErrCodeOperatorNotInitialized: "OperatorNotInitialized",
+	ErrCodeOperatorNotRegistered:  "OperatorNotRegistered",
+	ErrCodeTaskNotFound:           "TaskNotFound",
+	ErrCodeTaskMismatch:           "TaskMismatch",
+	ErrCodeTaskTerminated:         "TaskTerminated",
+	ErrCodeInvalidSignature:       "InvalidSignature",
+	ErrCodeDuplicateSignature:     "DuplicateSignature",
+	ErrCodeTaskFinished:           "TaskFinished",
+	ErrCodeRollupNotSupported:     "RollupNotSupported",
+}
+
+func (c AggregatorErrorCode) String() string {
+	if name, ok := aggregatorErrorCodeNames[c]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("Unknown(%d)", uint32(c))
+}
+
+// AggregatorError is the error with code returned by aggregator, the net/rpc and gRPC can only pass the
+// error message, so the code is kept in the message, can use `ParseAggregatorError` to get it back.
+type AggregatorError struct {
+	Code    AggregatorErrorCode
+	Message string
+}
+
+func NewAggregatorError(code AggregatorErrorCode, format string, args ...interface{}) *AggregatorError {
+	return &AggregatorError{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func (e *AggregatorError) Error() string {
+	return fmt.Sprintf("aggregator error %d %s: %s", uint32(e.Code), e.Code, e.Message)
+}
+
+// ErrorCode is used as the JSON RPC error code.
+func (e *AggregatorError) ErrorCode() int {
+	return int(e.Code)
+}
+
+var aggregatorErrorRegexp = regexp.MustCompile(`aggregator error (\d+) \w+(?:\(\d+\))?: (.*)`)
+
+// ParseAggregatorError gets the aggregator error from the error returned by any transport.
+func ParseAggregatorError(err error) (*AggregatorError, bool) {
+	if err == nil {
+		return nil, false
+	}
+
+	var aggErr *AggregatorError
+	if errors.As(err, &aggErr) {
+		return aggErr, true
+	}
+
+	matches := aggregatorErrorRegexp.FindStringSubmatch(err.Error())
+	if matches == nil {
+		return nil, false
+	}
+
+	code, parseErr := strconv.ParseUint(matches[1], 10, 32)
+	if parseErr != nil {
+		return nil, false
+	}
+
+	return &AggregatorError{
+		Code:    AggregatorErrorCode(code),
+		Message: matches[2],
+	}, true
+}
@@ -0,0 +1,168 @@
+package message
+
+import (
+	"fmt"
+
+	sdktypes "github.com/Layr-Labs/eigensdk-go/types"
+	"github.com/alt-research/avs/legacy/aggregator/types"
+	"github.com/alt-research/avs/legacy/api/grpc/aggregator"
+)
+
+// The type of the task event
+type TaskEventType string
+
+const (
+	TaskEventCreated           TaskEventType = "created"
+	TaskEventSignatureAccepted TaskEventType = "signatureAccepted"
+	TaskEventQuorumReached     TaskEventType = "quorumReached"
+	TaskEventSubmitted         TaskEventType = "submitted"
+	TaskEventConfirmed         TaskEventType = "confirmed"
+	TaskEventExpired           TaskEventType = "expired"
+	TaskEventFailed            TaskEventType = "failed"
+)
+
+// IsFinal returns if the event is the last event for the task.
+func (t TaskEventType) IsFinal() bool {
+	return t == TaskEventConfirmed || t == TaskEventExpired || t == TaskEventFailed
+}
+
+// The event of a task, pushed to the subscribers
+type TaskEvent struct {
+	Type          TaskEventType
+	TaskIndex     types.TaskIndex
+	AlertHash     Bytes32
+	RollupChainId uint32
+	OperatorId    sdktypes.OperatorId
+	TxHash        [32]byte
+	BlockNumber   uint64
+	Reason        string
+	Timestamp     int64
+}
+
+func NewTaskEvent(req *aggregator.TaskEvent) (*TaskEvent, error) {
+	alertHash := req.GetAlertHash()
+	if len(alertHash) != 32 {
+		return nil, fmt.Errorf("alertHash len should be 32")
+	}
+
+	res := &TaskEvent{
+		Type:          TaskEventType(req.GetType()),
+		TaskIndex:     req.GetTaskIndex(),
+		RollupChainId: req.GetRollupChainId(),
+		BlockNumber:   req.GetBlockNumber(),
+		Reason:        req.GetReason(),
+		Timestamp:     req.GetTimestamp(),
+	}
+
+	copy(res.AlertHash[:], alertHash[:32])
+
+	operatorId := req.GetOperatorId()
+	if len(operatorId) != 0 {
+		if len(operatorId) != 32 {
+			return nil, fmt.Errorf("operatorId len should be 32")
+		}
+		copy(res.OperatorId[:], operatorId[:32])
+	}
+
+	txHash := req.GetTxHash()
+	if len(txHash) != 0 {
+		if len(txHash) != 32 {
+			return nil, fmt.Errorf("txHash len should be 32")
+		}
+		copy(res.TxHash[:], txHash[:32])
+	}
+
+	return res, nil
+}
+
+func (r TaskEvent) ToPbType() *aggregator.TaskEvent {
+	res := &aggregator.TaskEvent{
+		Type:          string(r.Type),
+		TaskIndex:     r.TaskIndex,
+		AlertHash:     r.AlertHash[:],
+		RollupChainId: r.RollupChainId,
+		BlockNumber:   r.BlockNumber,
+		Reason:        r.Reason,
+		Timestamp:     r.Timestamp,
+	}
+
+	if r.OperatorId != (sdktypes.OperatorId{}) {
+		res.OperatorId = r.OperatorId[:]
+	}
+
+	if r.TxHash != ([32]byte{}) {
+		res.TxHash = r.TxHash[:]
+	}
+
+	return res
+}
+
+// The request to subscribe the task events, the AlertHash, RollupChainId and Types is the filter, empty for all.
+type SubscribeTaskEventsRequest struct {
+	AlertHash     *Bytes32
+	RollupChainId uint32
+	Types         []TaskEventType
+}
+
+func NewSubscribeTaskEventsRequest(req *aggregator.SubscribeTaskEventsRequest) (*SubscribeTaskEventsRequest, error) {
+	res := &SubscribeTaskEventsRequest{
+		RollupChainId: req.GetRollupChainId(),
+		Types:         make([]TaskEventType, 0, len(req.GetTypes())),
+	}
+
+	alertHash := req.GetAlertHash()
+	if len(alertHash) != 0 {
+		if len(alertHash) != 32 {
+			return nil, fmt.Errorf("alertHash len should be 32")
+		}
+
+		res.AlertHash = &Bytes32{}
+		copy(res.AlertHash[:], alertHash[:32])
+	}
+
+	for _, typ := range req.GetTypes() {
+		res.Types = append(res.Types, TaskEventType(typ))
+	}
+
+	return res, nil
+}
+
+func (r SubscribeTaskEventsRequest) ToPbType() *aggregator.SubscribeTaskEventsRequest {
+	res := &aggregator.SubscribeTaskEventsRequest{
+		RollupChainId: r.RollupChainId,
+		Types:         make([]string, 0, len(r.Types)),
+	}
+
+	if r.AlertHash != nil {
+		res.AlertHash = r.AlertHash[:]
+	}
+
+	for _, typ := range r.Types {
+		res.Types = append(res.Types, string(typ))
+	}
+
+	return res
+}
+
+// Match returns if the event should be sent to the subscriber.
+func (r SubscribeTaskEventsRequest) Match(event *TaskEvent) bool {
+	if r.AlertHash != nil && *r.AlertHash != event.AlertHash {
+		return false
+	}
+
+	if r.RollupChainId != 0 && r.RollupChainId != event.RollupChainId {
+		return false
+	}
+
+	if len(r.Types) == 0 {
+		return true
+	}
+
+	for _, typ := range r.Types {
+		if typ == event.Type {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,155 @@
+package message
+
+import (
+	"fmt"
+
+	"github.com/alt-research/avs/legacy/aggregator/types"
+	"github.com/alt-research/avs/legacy/api/grpc/aggregator"
+)
+
+const (
+	DefaultListTasksLimit = 100
+	MaxListTasksLimit     = 1000
+)
+
+// The request to get the status of a task, if the AlertHash is not nil,
+// will return the latest task for the alert in the rollup, else will use the TaskIndex.
+// The RollupChainId 0 means the default rollup of the aggregator.
+type GetTaskStatusRequest struct {
+	AlertHash     *Bytes32
+	RollupChainId uint32
+	TaskIndex     types.TaskIndex
+}
+
+func NewGetTaskStatusRequest(req *aggregator.GetTaskStatusRequest) (*GetTaskStatusRequest, error) {
+	res := &GetTaskStatusRequest{
+		RollupChainId: req.GetRollupChainId(),
+		TaskIndex:     req.GetTaskIndex(),
+	}
+
+	alertHash := req.GetAlertHash()
+	if len(alertHash) != 0 {
+		if len(alertHash) != 32 {
+			return nil, fmt.Errorf("alertHash len should be 32")
+		}
+
+		res.AlertHash = &Bytes32{}
+		copy(res.AlertHash[:], alertHash[:32])
+	}
+
+	return res, nil
+}
+
+// The status of a task
+type TaskStatus struct {
+	Info                   AlertTaskInfo
+	State                  string
+	Reason                 string
+	SignerCount            uint32
+	SignedStakePercentages []uint8
+	TxHash                 [32]byte
+	BlockNumber            uint64
+	UpdatedAt              int64
+}
+
+func NewTaskStatus(req *aggregator.TaskStatus) (*TaskStatus, error) {
+	info, err := NewAlertTaskInfo(req.GetInfo())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &TaskStatus{
+		Info:                   *info,
+		State:                  req.GetState(),
+		Reason:                 req.GetReason(),
+		SignerCount:            req.GetSignerCount(),
+		SignedStakePercentages: req.GetSignedStakePercentages(),
+		BlockNumber:            req.GetBlockNumber(),
+		UpdatedAt:              req.GetUpdatedAt(),
+	}
+
+	txHash := req.GetTxHash()
+	if len(txHash) != 0 {
+		if len(txHash) != 32 {
+			return nil, fmt.Errorf("txHash len should be 32")
+		}
+		copy(res.TxHash[:], txHash[:32])
+	}
+
+	return res, nil
+}
+
+func (r TaskStatus) ToPbType() *aggregator.TaskStatus {
+	return &aggregator.TaskStatus{
+		Info:                   r.Info.ToPbType(),
+		State:                  r.State,
+		Reason:                 r.Reason,
+		SignerCount:            r.SignerCount,
+		SignedStakePercentages: r.SignedStakePercentages,
+		TxHash:                 r.TxHash[:],
+		BlockNumber:            r.BlockNumber,
+		UpdatedAt:              r.UpdatedAt,
+	}
+}
+
+// The request to list the tasks, the State, AlertHash and RollupChainId is the filter, empty for all.
+type ListTasksRequest struct {
+	State          string
+	AlertHash      *Bytes32
+	RollupChainId  uint32
+	StartTaskIndex types.TaskIndex
+	Limit          uint32
+}
+
+func NewListTasksRequest(req *aggregator.ListTasksRequest) (*ListTasksRequest, error) {
+	res := &ListTasksRequest{
+		State:          req.GetState(),
+		RollupChainId:  req.GetRollupChainId(),
+		StartTaskIndex: req.GetStartTaskIndex(),
+		Limit:          req.GetLimit(),
+	}
+
+	alertHash := req.GetAlertHash()
+	if len(alertHash) != 0 {
+		if len(alertHash) != 32 {
+			return nil, fmt.Errorf("alertHash len should be 32")
+		}
+
+		res.AlertHash = &Bytes32{}
+		copy(res.AlertHash[:], alertHash[:32])
+	}
+
+	return res, nil
+}
+
+// GetLimit returns the limit to use, the default limit used if not set.
+func (r ListTasksRequest) GetLimit() int {
+	if r.Limit == 0 {
+		return DefaultListTasksLimit
+	}
+
+	if r.Limit > MaxListTasksLimit {
+		return MaxListTasksLimit
+	}
+
+	return int(r.Limit)
+}
+
+type ListTasksResponse struct {
+	Tasks         []TaskStatus
+	HasMore       bool
+	NextTaskIndex types.TaskIndex
+}
+
+func (r ListTasksResponse) ToPbType() *aggregator.ListTasksResponse {
+	tasks := make([]*aggregator.TaskStatus, 0, len(r.Tasks))
+	for _, task := range r.Tasks {
+		tasks = append(tasks, task.ToPbType())
+	}
+
+	return &aggregator.ListTasksResponse{
+		Tasks:         tasks,
+		HasMore:       r.HasMore,
+		NextTaskIndex: r.NextTaskIndex,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"log/slog"
+	"math/big"
 
 	"github.com/Layr-Labs/eigensdk-go/crypto/bls"
 	sdktypes "github.com/Layr-Labs/eigensdk-go/types"
@@ -39,6 +40,7 @@ type AlertTaskInfo struct {
 	QuorumThresholdPercentages sdktypes.QuorumThresholdPercentages
 	TaskIndex                  types.TaskIndex
 	ReferenceBlockNumber       uint64
+	RollupChainId              uint32
 }
 
 func NewAlertTaskInfo(req *aggregator.AlertTaskInfo) (*AlertTaskInfo, error) {
@@ -52,6 +54,7 @@ func NewAlertTaskInfo(req *aggregator.AlertTaskInfo) (*AlertTaskInfo, error) {
 		QuorumThresholdPercentages: core.ConvertQuorumThresholdPercentagesFromBytes(req.GetQuorumThresholdPercentages()),
 		TaskIndex:                  req.GetTaskIndex(),
 		ReferenceBlockNumber:       req.GetReferenceBlockNumber(),
+		RollupChainId:              req.GetRollupChainId(),
 	}
 
 	copy(res.AlertHash[:], alertHash[:32])
@@ -66,12 +69,12 @@ func (r AlertTaskInfo) ToPbType() *aggregator.AlertTaskInfo {
 		QuorumThresholdPercentages: r.QuorumThresholdPercentages.UnderlyingType(),
 		TaskIndex:                  r.TaskIndex,
 		ReferenceBlockNumber:       r.ReferenceBlockNumber,
+		RollupChainId:              r.RollupChainId,
 	}
 }
 
 func (a *AlertTaskInfo) EncodeSigHash() ([]byte, error) {
-	// The order here has to match the field ordering of ReducedBatchHeader defined in IEigenDAServiceManager.sol
-	// ref: https://github.com/Layr-Labs/eigenda/blob/master/contracts/src/interfaces/IEigenDAServiceManager.sol#L43
+	// The order here has to match the field ordering of ReducedAlertHeader defined in IMachServiceManager.sol
 	AlertType, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
 		{
 			Name: "messageHash",
@@ -81,6 +84,10 @@ func (a *AlertTaskInfo) EncodeSigHash() ([]byte, error) {
 			Name: "referenceBlockNumber",
 			Type: "uint32",
 		},
+		{
+			Name: "rollupChainID",
+			Type: "uint256",
+		},
 	})
 	if err != nil {
 		return nil, err
@@ -95,9 +102,11 @@ func (a *AlertTaskInfo) EncodeSigHash() ([]byte, error) {
 	s := struct {
 		MessageHash          [32]byte
 		ReferenceBlockNumber uint32
+		RollupChainID        *big.Int
 	}{
 		MessageHash:          a.AlertHash,
 		ReferenceBlockNumber: uint32(a.ReferenceBlockNumber),
+		RollupChainID:        new(big.Int).SetUint64(uint64(a.RollupChainId)),
 	}
 
 	bytes, err := arguments.Pack(s)
@@ -128,6 +137,7 @@ func (a AlertTaskInfo) ToIMachServiceManagerAlertHeader() csservicemanager.IMach
 		QuorumNumbers:              a.QuorumNumbers.UnderlyingType(),
 		QuorumThresholdPercentages: a.QuorumThresholdPercentages.UnderlyingType(),
 		ReferenceBlockNumber:       uint32(a.ReferenceBlockNumber),
+		RollupChainID:              new(big.Int).SetUint64(uint64(a.RollupChainId)),
 	}
 }
 
@@ -139,6 +149,7 @@ type InitOperatorRequest struct {
 	OperatorAddress            common.Address
 	OperatorStateRetrieverAddr common.Address
 	RegistryCoordinatorAddr    common.Address
+	Auth                       *OperatorAuth
 }
 
 func NewInitOperatorRequest(req *aggregator.InitOperatorRequest) (*InitOperatorRequest, error) {
@@ -162,12 +173,18 @@ func NewInitOperatorRequest(req *aggregator.InitOperatorRequest) (*InitOperatorR
 	}
 	registryCoordinatorAddr := common.HexToAddress(req.GetRegistryCoordinatorAddr())
 
+	auth, err := NewOperatorAuthFromPb(req.GetAuth())
+	if err != nil {
+		return nil, err
+	}
+
 	res := &InitOperatorRequest{
 		Layer1ChainId:              req.GetLayer1ChainId(),
 		ChainId:                    req.GetChainId(),
 		OperatorAddress:            operatorAddress,
 		OperatorStateRetrieverAddr: operatorStateRetrieverAddr,
 		RegistryCoordinatorAddr:    registryCoordinatorAddr,
+		Auth:                       auth,
 	}
 
 	copy(res.OperatorId[:], operatorId[:32])
@@ -188,9 +205,11 @@ func (r InitOperatorResponse) ToPbType() *aggregator.InitOperatorResponse {
 	}
 }
 
-// The Alert task create request
+// The Alert task create request, the RollupChainId 0 means the default rollup of the aggregator.
 type CreateTaskRequest struct {
-	AlertHash Bytes32
+	AlertHash     Bytes32
+	RollupChainId uint32
+	Auth          *OperatorAuth
 }
 
 func NewCreateTaskRequest(req *aggregator.CreateTaskRequest) (*CreateTaskRequest, error) {
@@ -199,7 +218,15 @@ func NewCreateTaskRequest(req *aggregator.CreateTaskRequest) (*CreateTaskRequest
 		return nil, fmt.Errorf("alertHash len should be 32")
 	}
 
-	res := &CreateTaskRequest{}
+	auth, err := NewOperatorAuthFromPb(req.GetAuth())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &CreateTaskRequest{
+		RollupChainId: req.GetRollupChainId(),
+		Auth:          auth,
+	}
 
 	copy(res.AlertHash[:], alertHash[:32])
 
@@ -221,6 +248,7 @@ type SignedTaskRespRequest struct {
 	Alert        AlertTaskInfo
 	BlsSignature bls.Signature
 	OperatorId   sdktypes.OperatorId
+	Auth         *OperatorAuth
 }
 
 func NewSignedTaskRespRequest(req *aggregator.SignedTaskRespRequest) (*SignedTaskRespRequest, error) {
@@ -242,9 +270,15 @@ func NewSignedTaskRespRequest(req *aggregator.SignedTaskRespRequest) (*SignedTas
 	g1Point := bls.NewZeroG1Point().Deserialize(signRaw)
 	sign := bls.Signature{G1Point: g1Point}
 
+	auth, err := NewOperatorAuthFromPb(req.GetAuth())
+	if err != nil {
+		return nil, err
+	}
+
 	res := &SignedTaskRespRequest{
 		Alert:        *alert,
 		BlsSignature: sign,
+		Auth:         auth,
 	}
 
 	copy(res.OperatorId[:], operatorId[:32])
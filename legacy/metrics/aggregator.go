@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/Layr-Labs/eigensdk-go/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+type AggregatorMetrics interface {
+	metrics.Metrics
+	IncNumTasksByState(state string)
+	ObserveGRpcRequest(method string, code string, duration time.Duration)
+}
+
+// AggregatorAndEigenMetrics contains instrumented metrics that should be incremented by the aggregator
+type AggregatorAndEigenMetrics struct {
+	metrics.Metrics
+	numTasksByState     *prometheus.CounterVec
+	numGRpcRequests     *prometheus.CounterVec
+	gRpcRequestDuration *prometheus.HistogramVec
+}
+
+func NewAggregatorAndEigenMetrics(eigenMetrics *metrics.EigenMetrics, reg prometheus.Registerer) *AggregatorAndEigenMetrics {
+	return &AggregatorAndEigenMetrics{
+		Metrics: eigenMetrics,
+		numTasksByState: promauto.With(reg).NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: MachNamespace,
+				Name:      "aggregator_num_tasks_by_state",
+				Help:      "The number of tasks changed into each state in the aggregator",
+			}, []string{"state"}),
+		numGRpcRequests: promauto.With(reg).NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: MachNamespace,
+				Name:      "aggregator_grpc_requests_total",
+				Help:      "The number of gRPC requests handled by the aggregator, by the method and status code",
+			}, []string{"method", "code"}),
+		gRpcRequestDuration: promauto.With(reg).NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: MachNamespace,
+				Name:      "aggregator_grpc_request_duration_seconds",
+				Help:      "The duration of the gRPC requests handled by the aggregator, by the method",
+				Buckets:   prometheus.DefBuckets,
+			}, []string{"method"}),
+	}
+}
+
+func (m *AggregatorAndEigenMetrics) IncNumTasksByState(state string) {
+	m.numTasksByState.WithLabelValues(state).Inc()
+}
+
+func (m *AggregatorAndEigenMetrics) ObserveGRpcRequest(method string, code string, duration time.Duration) {
+	m.numGRpcRequests.WithLabelValues(method, code).Inc()
+	m.gRpcRequestDuration.WithLabelValues(method).Observe(duration.Seconds())
+}
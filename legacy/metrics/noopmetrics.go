@@ -1,6 +1,8 @@
 package metrics
 
 import (
+	"time"
+
 	eigenmetrics "github.com/Layr-Labs/eigensdk-go/metrics"
 )
 
@@ -17,3 +19,7 @@ func NewNoopMetrics() *NoopMetrics {
 func (m *NoopMetrics) IncNumTasksReceived() {}
 
 func (m *NoopMetrics) IncNumTasksAcceptedByAggregator() {}
+
+func (m *NoopMetrics) IncNumTasksByState(state string) {}
+
+func (m *NoopMetrics) ObserveGRpcRequest(method string, code string, duration time.Duration) {}
@@ -0,0 +1,422 @@
+package operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigensdk-go/crypto/bls"
+	sdklogging "github.com/Layr-Labs/eigensdk-go/logging"
+	sdktypes "github.com/Layr-Labs/eigensdk-go/types"
+
+	"github.com/alt-research/avs/legacy/aggregator/types"
+	"github.com/alt-research/avs/legacy/core/alert"
+	"github.com/alt-research/avs/legacy/core/config"
+	"github.com/alt-research/avs/legacy/core/message"
+	"github.com/alt-research/avs/legacy/metrics"
+)
+
+const (
+	defaultAggregatorHealthCheckInterval = 10 * time.Second
+	// the timeout to connect to the aggregator when probing
+	aggregatorProbeTimeout = 3 * time.Second
+	// the time to keep the aggregator which created the task, should be longer than the task alive
+	aggregatorTaskPinRetention = time.Hour
+)
+
+// AggregatorTaskPinner is implemented by the client which sends the signed task response to the aggregator
+// which created the task, so the pins can be kept with the signed task responses and restored after restart.
+type AggregatorTaskPinner interface {
+	// TaskAggregator returns the name of the aggregator which created the task.
+	TaskAggregator(taskIndex types.TaskIndex, alertHash [32]byte) (string, bool)
+	// PinTaskAggregator makes the signed task response of the task sent to the aggregator.
+	PinTaskAggregator(taskIndex types.TaskIndex, alertHash [32]byte, aggregator string)
+}
+
+// aggregatorTaskKey identifies the task in an aggregator, the task index is only unique in its aggregator.
+type aggregatorTaskKey struct {
+	taskIndex types.TaskIndex
+	alertHash [32]byte
+}
+
+type aggregatorTaskPin struct {
+	endpoint int
+	pinnedAt time.Time
+}
+
+type aggregatorEndpoint struct {
+	// the transport with the address, unique in the endpoints
+	name string
+	// the `host:port` to probe the aggregator
+	probeAddr string
+	client    AggregatorRpcClienter
+	healthy   bool
+}
+
+// failoverAggregatorClient sends the requests to the first healthy aggregator by priority, fails over to the
+// standby aggregators if the prior ones unreachable, and fails back when they recovered by the health probe.
+// The signed task response is always sent to the aggregator which created the task.
+type failoverAggregatorClient struct {
+	logger    sdklogging.Logger
+	endpoints []*aggregatorEndpoint
+	interval  time.Duration
+
+	mu sync.RWMutex
+	// the endpoint used for the new tasks, the first healthy one
+	current int
+	pins    map[aggregatorTaskKey]aggregatorTaskPin
+}
+
+var (
+	_ AggregatorRpcClienter = (*failoverAggregatorClient)(nil)
+	_ AggregatorTaskPinner  = (*failoverAggregatorClient)(nil)
+)
+
+func newFailoverAggregatorClient(
+	c config.NodeConfig,
+	operatorId sdktypes.OperatorId,
+	blsKeypair *bls.KeyPair,
+	operatorAddr common.Address,
+	logger sdklogging.Logger,
+	metrics metrics.Metrics,
+) (*failoverAggregatorClient, error) {
+	if len(c.AggregatorEndpoints) == 0 {
+		return nil, errors.New("no aggregator endpoints")
+	}
+
+	interval := c.AggregatorHealthCheckInterval
+	if interval <= 0 {
+		interval = defaultAggregatorHealthCheckInterval
+	}
+
+	res := &failoverAggregatorClient{
+		logger:   logger,
+		interval: interval,
+		pins:     make(map[aggregatorTaskKey]aggregatorTaskPin),
+	}
+
+	names := make(map[string]struct{}, len(c.AggregatorEndpoints))
+	for i, endpoint := range c.AggregatorEndpoints {
+		name := fmt.Sprintf("%s(%s)", endpoint.Transport, endpoint.Address)
+		if _, ok := names[name]; ok {
+			return nil, fmt.Errorf("the aggregator endpoint %s is duplicated", name)
+		}
+		names[name] = struct{}{}
+
+		probeAddr, err := aggregatorProbeAddr(endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("the aggregator endpoint %d %s is invalid: %w", i, name, err)
+		}
+
+		client, err := buildAggregatorEndpointClient(c, endpoint, operatorId, blsKeypair, operatorAddr, logger, metrics)
+		if err != nil {
+			return nil, fmt.Errorf("create the client for aggregator endpoint %s failed: %w", name, err)
+		}
+
+		logger.Info("Use the aggregator endpoint", "priority", i, "name", name)
+
+		res.endpoints = append(res.endpoints, &aggregatorEndpoint{
+			name:      name,
+			probeAddr: probeAddr,
+			client:    client,
+		})
+	}
+
+	return res, nil
+}
+
+// buildAggregatorEndpointClient creates the client of the transport to the address of the endpoint.
+func buildAggregatorEndpointClient(
+	c config.NodeConfig,
+	endpoint config.AggregatorEndpoint,
+	operatorId sdktypes.OperatorId,
+	blsKeypair *bls.KeyPair,
+	operatorAddr common.Address,
+	logger sdklogging.Logger,
+	metrics metrics.Metrics,
+) (AggregatorRpcClienter, error) {
+	switch endpoint.Transport {
+	case config.AggregatorTransportJSONRPC:
+		c.AggregatorJSONRPCServerIpPortAddr = endpoint.Address
+		return NewAggregatorJsonRpcClient(c, operatorId, blsKeypair, operatorAddr, logger, metrics)
+	case config.AggregatorTransportGRPC:
+		c.AggregatorGRPCServerIpPortAddress = endpoint.Address
+		return NewAggregatorGRpcClient(c, operatorId, blsKeypair, operatorAddr, logger, metrics)
+	case config.AggregatorTransportRPC:
+		c.AggregatorServerIpPortAddress = endpoint.Address
+		return NewAggregatorRpcClient(c, operatorId, blsKeypair, operatorAddr, logger, metrics)
+	default:
+		return nil, fmt.Errorf("unknown aggregator transport %s", endpoint.Transport)
+	}
+}
+
+// aggregatorProbeAddr returns the `host:port` of the endpoint, the jsonrpc address is an url.
+func aggregatorProbeAddr(endpoint config.AggregatorEndpoint) (string, error) {
+	if endpoint.Address == "" {
+		return "", errors.New("the address is empty")
+	}
+
+	if endpoint.Transport != config.AggregatorTransportJSONRPC {
+		return endpoint.Address, nil
+	}
+
+	u, err := url.Parse(endpoint.Address)
+	if err != nil {
+		return "", err
+	}
+
+	if u.Port() != "" {
+		return u.Host, nil
+	}
+
+	switch u.Scheme {
+	case "http", "ws":
+		return net.JoinHostPort(u.Hostname(), "80"), nil
+	case "https", "wss":
+		return net.JoinHostPort(u.Hostname(), "443"), nil
+	default:
+		return "", fmt.Errorf("unsupported scheme %s", u.Scheme)
+	}
+}
+
+// Start probes the aggregators by the interval until the ctx done.
+func (f *failoverAggregatorClient) Start(ctx context.Context) {
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			f.probe(ctx)
+			f.prunePins()
+		}
+	}
+}
+
+// probe checks the aggregators can be connected, the operator will be inited to the aggregator recovered,
+// as it may be restarted.
+func (f *failoverAggregatorClient) probe(ctx context.Context) {
+	for i, endpoint := range f.endpoints {
+		dialer := net.Dialer{Timeout: aggregatorProbeTimeout}
+		conn, err := dialer.DialContext(ctx, "tcp", endpoint.probeAddr)
+		if err != nil {
+			f.setHealthy(i, false, err)
+			continue
+		}
+		conn.Close()
+
+		if f.isHealthy(i) {
+			continue
+		}
+
+		if err := endpoint.client.InitOperatorToAggregator(ctx); err != nil {
+			f.setHealthy(i, false, err)
+			continue
+		}
+
+		f.setHealthy(i, true, nil)
+	}
+}
+
+func (f *failoverAggregatorClient) isHealthy(i int) bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return f.endpoints[i].healthy
+}
+
+// setHealthy marks the endpoint, then selects the first healthy endpoint for the new tasks.
+func (f *failoverAggregatorClient) setHealthy(i int, healthy bool, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	endpoint := f.endpoints[i]
+	if endpoint.healthy != healthy {
+		if healthy {
+			f.logger.Info("The aggregator recovered", "name", endpoint.name)
+		} else {
+			f.logger.Warn("The aggregator unreachable", "name", endpoint.name, "err", err)
+		}
+	}
+	endpoint.healthy = healthy
+
+	current := f.current
+	for j, endpoint := range f.endpoints {
+		if endpoint.healthy {
+			current = j
+			break
+		}
+	}
+
+	if current != f.current {
+		if current < f.current {
+			f.logger.Info("Fail back to the aggregator", "name", f.endpoints[current].name, "from", f.endpoints[f.current].name)
+		} else {
+			f.logger.Warn("Fail over to the aggregator", "name", f.endpoints[current].name, "from", f.endpoints[f.current].name)
+		}
+		f.current = current
+	}
+}
+
+// candidates returns the endpoints to try for a new task, the healthy ones by priority, then the others.
+func (f *failoverAggregatorClient) candidates() []int {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	res := make([]int, 0, len(f.endpoints))
+	for i, endpoint := range f.endpoints {
+		if endpoint.healthy {
+			res = append(res, i)
+		}
+	}
+	for i, endpoint := range f.endpoints {
+		if !endpoint.healthy {
+			res = append(res, i)
+		}
+	}
+
+	return res
+}
+
+// InitOperatorToAggregator inits the operator to all the aggregators, returns error only if all failed.
+func (f *failoverAggregatorClient) InitOperatorToAggregator(ctx context.Context) error {
+	var errs []error
+	for i, endpoint := range f.endpoints {
+		if err := endpoint.client.InitOperatorToAggregator(ctx); err != nil {
+			f.setHealthy(i, false, err)
+			errs = append(errs, fmt.Errorf("%s: %w", endpoint.name, err))
+			continue
+		}
+
+		f.setHealthy(i, true, nil)
+	}
+
+	if len(errs) == len(f.endpoints) {
+		return fmt.Errorf("init operator to all the aggregators failed: %w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// CreateAlertTaskToAggregator creates the task in the first healthy aggregator, tries the next one if the
+// aggregator unreachable, the error replied by the aggregator will be returned directly.
+func (f *failoverAggregatorClient) CreateAlertTaskToAggregator(ctx context.Context, alertHash [32]byte) (*message.AlertTaskInfo, error) {
+	var lastErr error
+	for _, i := range f.candidates() {
+		endpoint := f.endpoints[i]
+
+		info, err := endpoint.client.CreateAlertTaskToAggregator(ctx, alertHash)
+		if err == nil {
+			f.pin(aggregatorTaskKey{taskIndex: info.TaskIndex, alertHash: alertHash}, i)
+			return info, nil
+		}
+
+		if aggregatorErrorCode(err) != 0 || ctx.Err() != nil {
+			return nil, err
+		}
+
+		f.setHealthy(i, false, err)
+		lastErr = err
+	}
+
+	return nil, fmt.Errorf("create the task in all the aggregators failed: %w", lastErr)
+}
+
+// SendSignedTaskResponseToAggregator sends the response to the aggregator which created the task, the response
+// is rejected if the aggregator of the task unknown, as the task index is only valid in its aggregator.
+func (f *failoverAggregatorClient) SendSignedTaskResponseToAggregator(ctx context.Context, signedTaskResponse *message.SignedTaskRespRequest, resChan chan alert.AlertResponse) {
+	key := aggregatorTaskKey{taskIndex: signedTaskResponse.Alert.TaskIndex, alertHash: signedTaskResponse.Alert.AlertHash}
+
+	f.mu.RLock()
+	pin, ok := f.pins[key]
+	f.mu.RUnlock()
+
+	if !ok {
+		f.logger.Warn("The aggregator of the task unknown, reject the signed task response",
+			"taskIndex", key.taskIndex, "alert", common.Hash(key.alertHash))
+		resChan <- alert.AlertResponse{
+			Code:      codeTaskAggregatorUnknown,
+			TaskIndex: key.taskIndex,
+			Err:       fmt.Errorf("the aggregator of the task %d unknown", key.taskIndex),
+		}
+		return
+	}
+	i := pin.endpoint
+
+	endpointResChan := make(chan alert.AlertResponse, 1)
+	f.endpoints[i].client.SendSignedTaskResponseToAggregator(ctx, signedTaskResponse, endpointResChan)
+
+	res := <-endpointResChan
+	if res.Err != nil && res.Code == 0 && ctx.Err() == nil {
+		f.setHealthy(i, false, res.Err)
+	}
+
+	resChan <- res
+}
+
+// Close closes the clients which keep the connection to the aggregator.
+func (f *failoverAggregatorClient) Close() error {
+	var errs []error
+	for _, endpoint := range f.endpoints {
+		if closer, ok := endpoint.client.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", endpoint.name, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (f *failoverAggregatorClient) TaskAggregator(taskIndex types.TaskIndex, alertHash [32]byte) (string, bool) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	pin, ok := f.pins[aggregatorTaskKey{taskIndex: taskIndex, alertHash: alertHash}]
+	if !ok {
+		return "", false
+	}
+
+	return f.endpoints[pin.endpoint].name, true
+}
+
+func (f *failoverAggregatorClient) PinTaskAggregator(taskIndex types.TaskIndex, alertHash [32]byte, aggregator string) {
+	for i, endpoint := range f.endpoints {
+		if endpoint.name == aggregator {
+			f.pin(aggregatorTaskKey{taskIndex: taskIndex, alertHash: alertHash}, i)
+			return
+		}
+	}
+
+	f.logger.Warn("The aggregator of the task not in the endpoints", "taskIndex", taskIndex, "name", aggregator)
+}
+
+func (f *failoverAggregatorClient) pin(key aggregatorTaskKey, endpoint int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.pins[key] = aggregatorTaskPin{
+		endpoint: endpoint,
+		pinnedAt: time.Now(),
+	}
+}
+
+func (f *failoverAggregatorClient) prunePins() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for key, pin := range f.pins {
+		if time.Since(pin.pinnedAt) > aggregatorTaskPinRetention {
+			delete(f.pins, key)
+		}
+	}
+}
@@ -0,0 +1,335 @@
+package operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	sdklogging "github.com/Layr-Labs/eigensdk-go/logging"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alt-research/avs/legacy/core/alert"
+	"github.com/alt-research/avs/legacy/core/message"
+	"github.com/alt-research/avs/legacy/operator/mocks"
+)
+
+// newTestFailoverClient creates the failover client with the mock clients, the endpoints are healthy if set.
+func newTestFailoverClient(ctrl *gomock.Controller, healthy ...bool) (*failoverAggregatorClient, []*mocks.MockAggregatorRpcClienter) {
+	f := &failoverAggregatorClient{
+		logger:   sdklogging.NewNoopLogger(),
+		interval: time.Second,
+		pins:     make(map[aggregatorTaskKey]aggregatorTaskPin),
+	}
+
+	clients := make([]*mocks.MockAggregatorRpcClienter, 0, len(healthy))
+	for i, h := range healthy {
+		client := mocks.NewMockAggregatorRpcClienter(ctrl)
+		clients = append(clients, client)
+		f.endpoints = append(f.endpoints, &aggregatorEndpoint{
+			name:    fmt.Sprintf("grpc(aggregator-%d:8190)", i),
+			client:  client,
+			healthy: h,
+		})
+	}
+
+	for i, h := range healthy {
+		if h {
+			f.current = i
+			break
+		}
+	}
+
+	return f, clients
+}
+
+func TestFailoverCandidates(t *testing.T) {
+	cases := []struct {
+		name       string
+		healthy    []bool
+		candidates []int
+	}{
+		{name: "all healthy", healthy: []bool{true, true, true}, candidates: []int{0, 1, 2}},
+		{name: "primary unhealthy", healthy: []bool{false, true, true}, candidates: []int{1, 2, 0}},
+		{name: "only the last healthy", healthy: []bool{false, false, true}, candidates: []int{2, 0, 1}},
+		{name: "all unhealthy", healthy: []bool{false, false, false}, candidates: []int{0, 1, 2}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			f, _ := newTestFailoverClient(gomock.NewController(t), c.healthy...)
+
+			if candidates := f.candidates(); !reflect.DeepEqual(candidates, c.candidates) {
+				t.Fatalf("expect the candidates %v, got %v", c.candidates, candidates)
+			}
+		})
+	}
+}
+
+func TestFailoverSetHealthy(t *testing.T) {
+	f, _ := newTestFailoverClient(gomock.NewController(t), true, true, false)
+
+	errUnreachable := errors.New("aggregator unreachable")
+	steps := []struct {
+		name     string
+		endpoint int
+		healthy  bool
+		current  int
+	}{
+		{name: "fail over to the standby", endpoint: 0, healthy: false, current: 1},
+		{name: "keep the current if none healthy", endpoint: 1, healthy: false, current: 1},
+		{name: "fail over to the last recovered", endpoint: 2, healthy: true, current: 2},
+		{name: "keep the prior one if a later recovered", endpoint: 1, healthy: true, current: 1},
+		{name: "fail back to the primary", endpoint: 0, healthy: true, current: 0},
+		{name: "keep the primary if the standby unreachable", endpoint: 1, healthy: false, current: 0},
+	}
+
+	for _, step := range steps {
+		f.setHealthy(step.endpoint, step.healthy, errUnreachable)
+
+		if f.isHealthy(step.endpoint) != step.healthy {
+			t.Fatalf("%s: the endpoint %d should be healthy %v", step.name, step.endpoint, step.healthy)
+		}
+		if f.current != step.current {
+			t.Fatalf("%s: expect the current endpoint %d, got %d", step.name, step.current, f.current)
+		}
+	}
+}
+
+func TestFailoverProbe(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen failed: %v", err)
+	}
+	defer listener.Close()
+
+	// the address not listened after closed
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen failed: %v", err)
+	}
+	closedAddr := closed.Addr().String()
+	closed.Close()
+
+	ctrl := gomock.NewController(t)
+	f, clients := newTestFailoverClient(ctrl, false, true)
+	f.endpoints[0].probeAddr = listener.Addr().String()
+	f.endpoints[1].probeAddr = closedAddr
+
+	// the primary recovered is inited again before used
+	clients[0].EXPECT().InitOperatorToAggregator(gomock.Any()).Return(nil)
+
+	f.probe(context.Background())
+
+	if !f.isHealthy(0) || f.isHealthy(1) {
+		t.Fatalf("expect only the primary healthy after probed")
+	}
+	if f.current != 0 {
+		t.Fatalf("should fail back to the primary, got %d", f.current)
+	}
+
+	// the healthy one is not inited again
+	f.probe(context.Background())
+}
+
+func TestFailoverInitOperator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	f, clients := newTestFailoverClient(ctrl, true, true)
+
+	errUnreachable := errors.New("aggregator unreachable")
+	gomock.InOrder(
+		clients[0].EXPECT().InitOperatorToAggregator(gomock.Any()).Return(errUnreachable),
+		clients[0].EXPECT().InitOperatorToAggregator(gomock.Any()).Return(nil),
+	)
+	clients[1].EXPECT().InitOperatorToAggregator(gomock.Any()).Return(nil).Times(2)
+
+	if err := f.InitOperatorToAggregator(context.Background()); err != nil {
+		t.Fatalf("init operator should not failed if any aggregator inited, got %v", err)
+	}
+	if f.current != 1 {
+		t.Fatalf("should fail over to the standby, got %d", f.current)
+	}
+
+	if err := f.InitOperatorToAggregator(context.Background()); err != nil {
+		t.Fatalf("init operator failed: %v", err)
+	}
+	if f.current != 0 {
+		t.Fatalf("should fail back to the primary, got %d", f.current)
+	}
+
+	f.setHealthy(0, false, errUnreachable)
+	clients[0].EXPECT().InitOperatorToAggregator(gomock.Any()).Return(errUnreachable)
+	clients[1].EXPECT().InitOperatorToAggregator(gomock.Any()).Return(errUnreachable)
+	if err := f.InitOperatorToAggregator(context.Background()); err == nil {
+		t.Fatalf("init operator should failed if all aggregators failed")
+	}
+}
+
+func TestFailoverCreateTask(t *testing.T) {
+	alertHash := [32]byte{1}
+	errUnreachable := errors.New("aggregator unreachable")
+
+	t.Run("fail over if unreachable", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		f, clients := newTestFailoverClient(ctrl, true, true)
+
+		clients[0].EXPECT().CreateAlertTaskToAggregator(gomock.Any(), alertHash).Return(nil, errUnreachable)
+		clients[1].EXPECT().CreateAlertTaskToAggregator(gomock.Any(), alertHash).Return(&message.AlertTaskInfo{TaskIndex: 7, AlertHash: alertHash}, nil)
+
+		info, err := f.CreateAlertTaskToAggregator(context.Background(), alertHash)
+		if err != nil {
+			t.Fatalf("create the task failed: %v", err)
+		}
+		if info.TaskIndex != 7 {
+			t.Fatalf("expect the task 7 from the standby, got %d", info.TaskIndex)
+		}
+
+		if f.isHealthy(0) || f.current != 1 {
+			t.Fatalf("should fail over to the standby")
+		}
+
+		name, ok := f.TaskAggregator(7, alertHash)
+		if !ok || name != f.endpoints[1].name {
+			t.Fatalf("the task should be pinned to the standby, got %s", name)
+		}
+	})
+
+	t.Run("not fail over if rejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		f, clients := newTestFailoverClient(ctrl, true, true)
+
+		rejected := message.NewAggregatorError(message.ErrCodeTaskFinished, "the task already finished")
+		clients[0].EXPECT().CreateAlertTaskToAggregator(gomock.Any(), alertHash).Return(nil, rejected)
+
+		_, err := f.CreateAlertTaskToAggregator(context.Background(), alertHash)
+		if aggregatorErrorCode(err) != uint32(message.ErrCodeTaskFinished) {
+			t.Fatalf("expect the error of the aggregator returned, got %v", err)
+		}
+
+		if !f.isHealthy(0) || f.current != 0 {
+			t.Fatalf("the aggregator rejected the task should be healthy")
+		}
+	})
+
+	t.Run("all unreachable", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		f, clients := newTestFailoverClient(ctrl, true, false)
+
+		clients[0].EXPECT().CreateAlertTaskToAggregator(gomock.Any(), alertHash).Return(nil, errUnreachable)
+		clients[1].EXPECT().CreateAlertTaskToAggregator(gomock.Any(), alertHash).Return(nil, errUnreachable)
+
+		if _, err := f.CreateAlertTaskToAggregator(context.Background(), alertHash); !errors.Is(err, errUnreachable) {
+			t.Fatalf("expect the error of the last aggregator, got %v", err)
+		}
+	})
+}
+
+func TestFailoverSendSignedTaskResponse(t *testing.T) {
+	alertHash := [32]byte{1}
+	accepted := alert.AlertResponse{TaskIndex: 1, TxHash: [32]byte{9}, BlockNumber: 150}
+
+	cases := []struct {
+		name string
+		// the endpoint the task pinned to, -1 if not pinned
+		pinned int
+		res    alert.AlertResponse
+		code   uint32
+		// if the pinned endpoint should be healthy after sent
+		healthy bool
+	}{
+		{
+			name:    "sent to the pinned standby",
+			pinned:  1,
+			res:     accepted,
+			healthy: true,
+		},
+		{
+			name:    "rejected by the pinned aggregator",
+			pinned:  1,
+			res:     alert.AlertResponse{Err: errors.New("task expired"), Code: uint32(message.ErrCodeTaskTerminated)},
+			code:    uint32(message.ErrCodeTaskTerminated),
+			healthy: true,
+		},
+		{
+			name:   "the pinned aggregator unreachable",
+			pinned: 1,
+			res:    alert.AlertResponse{Err: errors.New("aggregator unreachable")},
+		},
+		{
+			name:   "not pinned",
+			pinned: -1,
+			code:   codeTaskAggregatorUnknown,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			// the primary is the current one, the task should not be sent to it
+			f, clients := newTestFailoverClient(ctrl, true, true)
+
+			signed := &message.SignedTaskRespRequest{Alert: message.AlertTaskInfo{TaskIndex: 1, AlertHash: alertHash}}
+
+			if c.pinned >= 0 {
+				f.PinTaskAggregator(1, alertHash, f.endpoints[c.pinned].name)
+				clients[c.pinned].EXPECT().
+					SendSignedTaskResponseToAggregator(gomock.Any(), signed, gomock.Any()).
+					Do(func(ctx context.Context, signed *message.SignedTaskRespRequest, resChan chan alert.AlertResponse) {
+						resChan <- c.res
+					})
+			}
+
+			resChan := make(chan alert.AlertResponse, 1)
+			f.SendSignedTaskResponseToAggregator(context.Background(), signed, resChan)
+
+			res := <-resChan
+			if res.Code != c.code {
+				t.Fatalf("expect the code %d, got %d: %v", c.code, res.Code, res.Err)
+			}
+			if c.code == 0 && c.res.Err == nil && res != accepted {
+				t.Fatalf("expect the response %+v, got %+v", accepted, res)
+			}
+
+			if c.pinned >= 0 && f.isHealthy(c.pinned) != c.healthy {
+				t.Fatalf("the pinned endpoint should be healthy %v", c.healthy)
+			}
+		})
+	}
+}
+
+func TestFailoverPins(t *testing.T) {
+	f, _ := newTestFailoverClient(gomock.NewController(t), true, true)
+
+	f.PinTaskAggregator(1, [32]byte{1}, f.endpoints[1].name)
+	f.PinTaskAggregator(2, [32]byte{2}, f.endpoints[0].name)
+	// the aggregator removed from the endpoints
+	f.PinTaskAggregator(3, [32]byte{3}, "grpc(removed-aggregator:8190)")
+
+	if name, ok := f.TaskAggregator(1, [32]byte{1}); !ok || name != f.endpoints[1].name {
+		t.Fatalf("the task 1 should be pinned to the standby, got %s", name)
+	}
+	// the task index is only unique in the aggregator
+	if _, ok := f.TaskAggregator(1, [32]byte{2}); ok {
+		t.Fatalf("the task 1 of another alert should not be pinned")
+	}
+	if _, ok := f.TaskAggregator(3, [32]byte{3}); ok {
+		t.Fatalf("the task of the unknown aggregator should not be pinned")
+	}
+
+	// the pin of the task 2 is older than the retention
+	f.pins[aggregatorTaskKey{taskIndex: 2, alertHash: [32]byte{2}}] = aggregatorTaskPin{
+		endpoint: 0,
+		pinnedAt: time.Now().Add(-aggregatorTaskPinRetention - time.Minute),
+	}
+	f.prunePins()
+
+	if _, ok := f.TaskAggregator(2, [32]byte{2}); ok {
+		t.Fatalf("the pin older than the retention should be pruned")
+	}
+	if _, ok := f.TaskAggregator(1, [32]byte{1}); !ok {
+		t.Fatalf("the pin in the retention should be kept")
+	}
+}
@@ -0,0 +1,148 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdklogging "github.com/Layr-Labs/eigensdk-go/logging"
+
+	"github.com/alt-research/avs/legacy/core/alert"
+)
+
+const (
+	defaultAlertWorkers = 8
+	// the default time to process an alert, not including waiting the task submitted
+	defaultAlertTimeout = time.Minute
+	// the max count of the alerts waiting for the workers
+	alertQueueSize = 256
+)
+
+type alertProcessor func(ctx context.Context, newAlert alert.Alert) alert.AlertResponse
+
+// inflightAlert is an alert being processed, the same alert submitted again will wait for its response.
+type inflightAlert struct {
+	hash    [32]byte
+	alert   alert.Alert
+	waiters []chan alert.AlertResponse
+}
+
+// alertPool processes the alerts by a bounded count of workers, each alert with a deadline,
+// the alerts with the same hash are coalesced to one processing.
+type alertPool struct {
+	logger  sdklogging.Logger
+	process alertProcessor
+	workers int
+	timeout time.Duration
+
+	jobs chan *inflightAlert
+
+	mu       sync.Mutex
+	inflight map[[32]byte]*inflightAlert
+}
+
+func newAlertPool(
+	logger sdklogging.Logger,
+	workers int,
+	timeout time.Duration,
+	waitTaskSubmittedTimeout time.Duration,
+	process alertProcessor,
+) *alertPool {
+	if workers <= 0 {
+		workers = defaultAlertWorkers
+	}
+	if timeout <= 0 {
+		timeout = defaultAlertTimeout + waitTaskSubmittedTimeout
+	}
+
+	return &alertPool{
+		logger:   logger,
+		process:  process,
+		workers:  workers,
+		timeout:  timeout,
+		jobs:     make(chan *inflightAlert, alertQueueSize),
+		inflight: make(map[[32]byte]*inflightAlert),
+	}
+}
+
+// Start starts the workers until the ctx done.
+func (p *alertPool) Start(ctx context.Context) {
+	p.logger.Info("Start the alert workers", "workers", p.workers, "timeout", p.timeout)
+
+	for i := 0; i < p.workers; i++ {
+		go p.work(ctx)
+	}
+}
+
+// Submit queues the alert, the response will be sent to the `ResChan` of the request,
+// if the alert is being processed, the request will get the same response.
+func (p *alertPool) Submit(ctx context.Context, req alert.AlertRequest) {
+	hash := req.Alert.MessageHash()
+
+	p.mu.Lock()
+	if job, ok := p.inflight[hash]; ok {
+		job.waiters = append(job.waiters, req.ResChan)
+		p.mu.Unlock()
+
+		p.logger.Info("The alert is processing, wait for its response", "alert", common.Hash(hash))
+		return
+	}
+
+	job := &inflightAlert{
+		hash:    hash,
+		alert:   req.Alert,
+		waiters: []chan alert.AlertResponse{req.ResChan},
+	}
+	p.inflight[hash] = job
+	p.mu.Unlock()
+
+	select {
+	case p.jobs <- job:
+	case <-ctx.Done():
+		p.finish(job, alert.AlertResponse{
+			Err: ctx.Err(),
+			Msg: "the operator is stopping",
+		})
+	}
+}
+
+func (p *alertPool) work(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case job := <-p.jobs:
+			p.run(ctx, job)
+		}
+	}
+}
+
+func (p *alertPool) run(ctx context.Context, job *inflightAlert) {
+	alertCtx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	p.finish(job, p.process(alertCtx, job.alert))
+}
+
+// finish sends the response to all the requests of the alert.
+func (p *alertPool) finish(job *inflightAlert, res alert.AlertResponse) {
+	p.mu.Lock()
+	delete(p.inflight, job.hash)
+	waiters := job.waiters
+	p.mu.Unlock()
+
+	for _, waiter := range waiters {
+		waiter <- res
+	}
+}
+
+func alertTimeoutResponse(err error) alert.AlertResponse {
+	return alert.AlertResponse{
+		Code: codeAlertTimeout,
+		Err:  fmt.Errorf("the alert not processed in time: %w", err),
+		Msg:  "process the alert timeout",
+	}
+}
@@ -0,0 +1,261 @@
+package operator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/clients/eth"
+	sdklogging "github.com/Layr-Labs/eigensdk-go/logging"
+
+	csservicemanager "github.com/alt-research/avs/contracts/bindings/MachServiceManager"
+	"github.com/alt-research/avs/legacy/core/alert"
+	"github.com/alt-research/avs/legacy/core/chainio"
+	"github.com/alt-research/avs/legacy/core/message"
+)
+
+const (
+	// the default blocks after the reference block to expire the alert, same as the aggregator 's default
+	defaultAlertExpiredBlocks uint64 = 100
+	// the interval to check the head block to expire the alerts
+	alertExpireCheckInterval = 12 * time.Second
+	// the time to keep the confirmed or expired alerts for querying
+	alertRecordRetention = 24 * time.Hour
+)
+
+// AlertState is the state of the alert signed by the operator.
+type AlertState string
+
+const (
+	// the alert is signed and sending to the aggregator
+	AlertStateSigned AlertState = "signed"
+	// the signature is accepted by the aggregator, waiting the alert confirmed
+	AlertStateAccepted AlertState = "accepted"
+	// the alert is confirmed by the `AlertConfirmed` event of the service manager
+	AlertStateConfirmed AlertState = "confirmed"
+	// the alert is not confirmed before the expired block
+	AlertStateExpired AlertState = "expired"
+)
+
+func (s AlertState) isTerminal() bool {
+	return s == AlertStateConfirmed || s == AlertStateExpired
+}
+
+// AlertRecord is the status of the alert signed by the operator.
+type AlertRecord struct {
+	AlertHash            alert.HexEncodedBytes32 `json:"alert_hash"`
+	RollupChainId        uint32                  `json:"rollup_chain_id"`
+	TaskIndex            uint64                  `json:"task_index"`
+	ReferenceBlockNumber uint64                  `json:"reference_block_number"`
+	// the layer1 block at which the alert expired if not confirmed
+	ExpiredBlockNumber uint64     `json:"expired_block_number"`
+	State              AlertState `json:"state"`
+	// the confirm alert tx, set by the aggregator response or the `AlertConfirmed` event
+	TxHash alert.HexEncodedBytes32 `json:"tx_hash"`
+	// the block which the `AlertConfirmed` event in
+	BlockNumber uint64 `json:"block_number,omitempty"`
+	// the error returned by the aggregator, the alert may still be confirmed by the signatures from other operators
+	Error     string `json:"error,omitempty"`
+	SignedAt  int64  `json:"signed_at"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+// AlertStatusGetter returns the status of the alert signed by the operator.
+type AlertStatusGetter interface {
+	GetAlertStatus(alertHash [32]byte) (*AlertRecord, bool)
+}
+
+// alertTracker keeps the alerts signed by the operator, and follows them until confirmed or expired.
+type alertTracker struct {
+	logger sdklogging.Logger
+	// the client to get the head block for expiring the alerts
+	ethClient eth.Client
+	// the indexer to follow the confirmed `AlertConfirmed` events, nil if no ws url configured
+	indexer       *chainio.ServiceManagerIndexer
+	webhook       *alertWebhook
+	expiredBlocks uint64
+
+	mu     sync.RWMutex
+	alerts map[[32]byte]*AlertRecord
+}
+
+var _ AlertStatusGetter = (*alertTracker)(nil)
+
+func newAlertTracker(
+	logger sdklogging.Logger,
+	ethClient eth.Client,
+	indexer *chainio.ServiceManagerIndexer,
+	webhook *alertWebhook,
+	expiredBlocks uint64,
+) *alertTracker {
+	if expiredBlocks == 0 {
+		expiredBlocks = defaultAlertExpiredBlocks
+	}
+
+	return &alertTracker{
+		logger:        logger,
+		ethClient:     ethClient,
+		indexer:       indexer,
+		webhook:       webhook,
+		expiredBlocks: expiredBlocks,
+		alerts:        make(map[[32]byte]*AlertRecord),
+	}
+}
+
+// Start follows the `AlertConfirmed` events emitted by the indexer after the confirmation depth,
+// and expires the alerts by the head block until the ctx done.
+func (t *alertTracker) Start(ctx context.Context) {
+	confirmedChan := make(chan *csservicemanager.ContractMachServiceManagerAlertConfirmed, 32)
+	if t.indexer != nil {
+		sub := t.indexer.SubscribeHandlers(&chainio.ServiceManagerEventHandlers{
+			OnAlertConfirmed: func(ev *csservicemanager.ContractMachServiceManagerAlertConfirmed) {
+				select {
+				case confirmedChan <- ev:
+				case <-ctx.Done():
+				}
+			},
+		})
+		defer sub.Unsubscribe()
+	} else {
+		t.logger.Warn("No eth ws client to watch the AlertConfirmed events, the alerts will be expired only")
+	}
+
+	ticker := time.NewTicker(alertExpireCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev := <-confirmedChan:
+			t.onAlertConfirmed(ev)
+		case <-ticker.C:
+			head, err := t.ethClient.BlockNumber(ctx)
+			if err != nil {
+				t.logger.Warn("Get the head block for expiring the alerts failed", "err", err)
+				continue
+			}
+			t.expire(head)
+			t.prune(time.Now())
+		}
+	}
+}
+
+// GetAlertStatus returns a copy of the status of the alert.
+func (t *alertTracker) GetAlertStatus(alertHash [32]byte) (*AlertRecord, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	record, ok := t.alerts[alertHash]
+	if !ok {
+		return nil, false
+	}
+
+	res := *record
+	return &res, true
+}
+
+// OnSigned records the alert signed by the operator.
+func (t *alertTracker) OnSigned(task *message.AlertTaskInfo) {
+	now := time.Now().Unix()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if record, ok := t.alerts[task.AlertHash]; ok && !record.State.isTerminal() {
+		// the alert signed again for the same task, just keep the record
+		return
+	}
+
+	t.alerts[task.AlertHash] = &AlertRecord{
+		AlertHash:            alert.HexEncodedBytes32(task.AlertHash),
+		RollupChainId:        task.RollupChainId,
+		TaskIndex:            uint64(task.TaskIndex),
+		ReferenceBlockNumber: task.ReferenceBlockNumber,
+		ExpiredBlockNumber:   task.ReferenceBlockNumber + t.expiredBlocks,
+		State:                AlertStateSigned,
+		SignedAt:             now,
+		UpdatedAt:            now,
+	}
+}
+
+// OnResponse updates the alert by the response of the aggregator for the signature.
+func (t *alertTracker) OnResponse(alertHash [32]byte, res alert.AlertResponse) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	record, ok := t.alerts[alertHash]
+	if !ok || record.State.isTerminal() {
+		return
+	}
+
+	if res.Err != nil {
+		record.Error = res.Err.Error()
+	} else {
+		record.State = AlertStateAccepted
+		record.Error = ""
+	}
+	if res.TxHash != [32]byte{} {
+		record.TxHash = res.TxHash
+	}
+	record.UpdatedAt = time.Now().Unix()
+}
+
+func (t *alertTracker) onAlertConfirmed(ev *csservicemanager.ContractMachServiceManagerAlertConfirmed) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	record, ok := t.alerts[ev.MessageHash]
+	if !ok {
+		return
+	}
+
+	if record.State == AlertStateConfirmed {
+		return
+	}
+
+	t.logger.Info("The alert is confirmed", "alert", common.Hash(ev.MessageHash), "block", ev.Raw.BlockNumber, "txHash", ev.Raw.TxHash)
+
+	record.State = AlertStateConfirmed
+	record.TxHash = alert.HexEncodedBytes32(ev.Raw.TxHash)
+	record.BlockNumber = ev.Raw.BlockNumber
+	record.Error = ""
+	record.UpdatedAt = time.Now().Unix()
+
+	t.webhook.Send(AlertWebhookEventConfirmed, *record)
+}
+
+// expire marks the alerts not confirmed before the head block as expired.
+func (t *alertTracker) expire(head uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now().Unix()
+	for hash, record := range t.alerts {
+		if record.State.isTerminal() || head < record.ExpiredBlockNumber {
+			continue
+		}
+
+		t.logger.Warn("The alert is expired", "alert", common.Hash(hash), "taskIndex", record.TaskIndex, "expiredBlock", record.ExpiredBlockNumber)
+
+		record.State = AlertStateExpired
+		record.UpdatedAt = now
+
+		t.webhook.Send(AlertWebhookEventExpired, *record)
+	}
+}
+
+// prune drops the confirmed or expired alerts after the retention.
+func (t *alertTracker) prune(now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	deadline := now.Add(-alertRecordRetention).Unix()
+	for hash, record := range t.alerts {
+		if record.State.isTerminal() && record.UpdatedAt < deadline {
+			delete(t.alerts, hash)
+		}
+	}
+}
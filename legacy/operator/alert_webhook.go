@@ -0,0 +1,86 @@
+package operator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdklogging "github.com/Layr-Labs/eigensdk-go/logging"
+)
+
+// the timeout to POST an event to the webhook
+const alertWebhookTimeout = 10 * time.Second
+
+// AlertWebhookEventType is the type of the alert lifecycle event sent to the webhook.
+type AlertWebhookEventType string
+
+const (
+	AlertWebhookEventConfirmed AlertWebhookEventType = "alert_confirmed"
+	AlertWebhookEventExpired   AlertWebhookEventType = "alert_expired"
+)
+
+// AlertWebhookEvent is the body POSTed to the webhook.
+type AlertWebhookEvent struct {
+	Event AlertWebhookEventType `json:"event"`
+	Alert AlertRecord           `json:"alert"`
+}
+
+// alertWebhook POSTs the alert lifecycle events to the verifier, a nil webhook sends nothing.
+type alertWebhook struct {
+	logger sdklogging.Logger
+	url    string
+	client *http.Client
+}
+
+// newAlertWebhook returns nil if the url is empty.
+func newAlertWebhook(logger sdklogging.Logger, url string) *alertWebhook {
+	if url == "" {
+		return nil
+	}
+
+	return &alertWebhook{
+		logger: logger,
+		url:    url,
+		client: &http.Client{
+			Timeout: alertWebhookTimeout,
+		},
+	}
+}
+
+// Send POSTs the event in background, the failure is only logged.
+func (w *alertWebhook) Send(eventType AlertWebhookEventType, record AlertRecord) {
+	if w == nil {
+		return
+	}
+
+	go func() {
+		if err := w.post(AlertWebhookEvent{Event: eventType, Alert: record}); err != nil {
+			w.logger.Error("Send the alert event to webhook failed", "event", eventType, "alert", common.Hash(record.AlertHash), "err", err)
+		}
+	}()
+}
+
+func (w *alertWebhook) post(ev AlertWebhookEvent) error {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %s", resp.Status)
+	}
+
+	w.logger.Debug("Sent the alert event to webhook", "event", ev.Event, "alert", common.Hash(ev.Alert.AlertHash))
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package operator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/clients/eth"
+	sdklogging "github.com/Layr-Labs/eigensdk-go/logging"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/alt-research/avs/legacy/core"
+	"github.com/alt-research/avs/legacy/core/chainio"
+	"github.com/alt-research/avs/legacy/core/config"
+)
+
+// ConfirmedAlerts is the confirmed alerts of a rollup printed by the cli.
+type ConfirmedAlerts struct {
+	RollupChainId uint32                    `json:"rollupChainId"`
+	Total         uint64                    `json:"total"`
+	Alerts        []*chainio.ConfirmedAlert `json:"alerts"`
+}
+
+// PrintConfirmedAlertsFromConfig prints the confirmed alerts like `PrintConfirmedAlerts`,
+// only the avs reader is built from the config, so no key or aggregator is needed.
+func PrintConfirmedAlertsFromConfig(ctx context.Context, cfg config.NodeConfig, rollupChainId uint32, start, size, fromBlock uint64) error {
+	logger, err := core.NewZapLogger(sdklogging.Development)
+	if err != nil {
+		return err
+	}
+
+	c := withEnvConfig(cfg)
+
+	ethRpcClient, err := eth.NewClient(c.EthRpcUrl)
+	if err != nil {
+		return fmt.Errorf("create the eth client failed: %w", err)
+	}
+
+	avsReader, err := chainio.BuildAvsReader(
+		common.HexToAddress(c.AVSRegistryCoordinatorAddress),
+		common.HexToAddress(c.OperatorStateRetrieverAddress),
+		ethRpcClient, logger)
+	if err != nil {
+		return fmt.Errorf("create the avs reader failed: %w", err)
+	}
+
+	if rollupChainId == 0 {
+		rollupChainId = c.Layer2ChainId
+	}
+
+	return PrintConfirmedAlerts(ctx, logger, avsReader, rollupChainId, start, size, fromBlock)
+}
+
+// PrintConfirmedAlerts prints at most size confirmed alerts of the rollup from the start index,
+// with the `AlertConfirmed` event looked up from the fromBlock.
+func PrintConfirmedAlerts(ctx context.Context, logger sdklogging.Logger, avsReader chainio.AvsReaderer, rollupChainId uint32, start, size, fromBlock uint64) error {
+	total, err := avsReader.CountAlerts(ctx, rollupChainId)
+	if err != nil {
+		return fmt.Errorf("count the alerts of rollup %d failed: %w", rollupChainId, err)
+	}
+
+	alerts, err := avsReader.ListConfirmedAlerts(ctx, rollupChainId, start, size)
+	if err != nil {
+		return fmt.Errorf("list the alerts of rollup %d failed: %w", rollupChainId, err)
+	}
+
+	err = avsReader.FindAlertConfirmedEvents(ctx, fromBlock, alerts)
+	if err != nil {
+		return fmt.Errorf("find the alert confirmed events failed: %w", err)
+	}
+
+	for _, alert := range alerts {
+		if !alert.IsEventFound() {
+			logger.Warn("the alert confirmed event not found, try an earlier from block", "index", alert.Index, "messageHash", alert.MessageHash)
+		}
+	}
+
+	if alerts == nil {
+		alerts = []*chainio.ConfirmedAlert{}
+	}
+
+	alertsJson, err := json.MarshalIndent(ConfirmedAlerts{
+		RollupChainId: rollupChainId,
+		Total:         total,
+		Alerts:        alerts,
+	}, "", " ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(alertsJson))
+
+	return nil
+}
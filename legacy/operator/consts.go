@@ -4,4 +4,5 @@ const (
 	ServiceOperator           string = "ServiceOperator"
 	ServiceOperatorAggregator string = "ServiceOperatorAggregator"
 	ServiceOperatorVerifier   string = "ServiceOperatorVerifier"
+	ServiceOperatorOutbox     string = "ServiceOperatorOutbox"
 )
@@ -2,12 +2,16 @@ package operator
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
-	"net/rpc"
+	"os"
 	"time"
 
+	"github.com/Layr-Labs/eigensdk-go/crypto/bls"
 	"github.com/Layr-Labs/eigensdk-go/logging"
 	sdktypes "github.com/Layr-Labs/eigensdk-go/types"
+	"github.com/alt-research/avs/legacy/aggregator/types"
 	"github.com/alt-research/avs/legacy/api/grpc/aggregator"
 	"github.com/alt-research/avs/legacy/core/alert"
 	"github.com/alt-research/avs/legacy/core/config"
@@ -15,15 +19,50 @@ import (
 	"github.com/alt-research/avs/legacy/metrics"
 	"github.com/ethereum/go-ethereum/common"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
 )
 
+const (
+	defaultAggregatorRequestTimeout        = 1 * time.Second
+	defaultAggregatorGRPCKeepaliveInterval = 30 * time.Second
+	// the time to wait the ping ack before closing the connection
+	aggregatorGRPCKeepaliveTimeout = 10 * time.Second
+	// the backoff to retry the authenticated calls, doubled for each retry until the max
+	aggregatorAuthRetryMinBackoff = 100 * time.Millisecond
+	aggregatorAuthRetryMaxBackoff = 1 * time.Second
+)
+
+// aggregatorGRPCServiceConfig retries the calls without auth when the aggregator unavailable, such as restarting,
+// the retries are limited by the request timeout. The authenticated calls are retried by `callWithAuth`,
+// as the aggregator rejects the auth used before, which will be resent by the gRPC retries.
+const aggregatorGRPCServiceConfig = `{
+	"methodConfig": [{
+		"name": [
+			{"service": "aggregator.Aggregator", "method": "GetTaskStatus"},
+			{"service": "aggregator.Aggregator", "method": "ListTasks"}
+		],
+		"retryPolicy": {
+			"maxAttempts": 5,
+			"initialBackoff": "0.1s",
+			"maxBackoff": "1s",
+			"backoffMultiplier": 2,
+			"retryableStatusCodes": ["UNAVAILABLE"]
+		}
+	}]
+}`
+
 type AggregatorGRpcClient struct {
-	rpcClient                  *rpc.Client
+	conn                       *grpc.ClientConn
+	client                     aggregator.AggregatorClient
 	metrics                    metrics.Metrics
 	logger                     logging.Logger
 	config                     config.NodeConfig
 	operatorId                 sdktypes.OperatorId
+	blsKeypair                 *bls.KeyPair
 	operatorAddr               common.Address
 	OperatorStateRetrieverAddr common.Address
 	RegistryCoordinatorAddr    common.Address
@@ -31,36 +70,129 @@ type AggregatorGRpcClient struct {
 	timeout                    time.Duration
 }
 
-func NewAggregatorGRpcClient(config config.NodeConfig, operatorId sdktypes.OperatorId, operatorAddr common.Address, logger logging.Logger, metrics metrics.Metrics) (*AggregatorGRpcClient, error) {
+// NewAggregatorGRpcClient creates the client with a connection to the aggregator reused by all the requests,
+// the connection is established lazily, so the client can be created even if the aggregator is not running.
+func NewAggregatorGRpcClient(config config.NodeConfig, operatorId sdktypes.OperatorId, blsKeypair *bls.KeyPair, operatorAddr common.Address, logger logging.Logger, metrics metrics.Metrics) (*AggregatorGRpcClient, error) {
+	creds, err := aggregatorGRPCCredentials(config.AggregatorGRPCTLS)
+	if err != nil {
+		return nil, fmt.Errorf("load the aggregator grpc tls failed: %w", err)
+	}
+
+	keepaliveInterval := config.AggregatorGRPCKeepaliveInterval
+	if keepaliveInterval <= 0 {
+		keepaliveInterval = defaultAggregatorGRPCKeepaliveInterval
+	}
+
+	conn, err := grpc.NewClient(
+		config.AggregatorGRPCServerIpPortAddress,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                keepaliveInterval,
+			Timeout:             aggregatorGRPCKeepaliveTimeout,
+			PermitWithoutStream: true,
+		}),
+		grpc.WithDefaultServiceConfig(aggregatorGRPCServiceConfig),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create the aggregator grpc connection failed: %w", err)
+	}
+
 	return &AggregatorGRpcClient{
-		// set to nil so that we can create an rpc client even if the aggregator is not running
-		rpcClient:                  nil,
+		conn:                       conn,
+		client:                     aggregator.NewAggregatorClient(conn),
 		metrics:                    metrics,
 		logger:                     logger,
 		config:                     config,
 		operatorId:                 operatorId,
+		blsKeypair:                 blsKeypair,
 		operatorAddr:               operatorAddr,
 		OperatorStateRetrieverAddr: common.HexToAddress(config.OperatorStateRetrieverAddress),
 		RegistryCoordinatorAddr:    common.HexToAddress(config.AVSRegistryCoordinatorAddress),
 		gRPCAggregatorIpPortAddr:   config.AggregatorGRPCServerIpPortAddress,
-		timeout:                    1 * time.Second,
+		timeout:                    aggregatorRequestTimeout(config),
 	}, nil
 }
 
-// CreateAlertTaskToAggregator create a new alert task, if had existing, just return current alert task.
-func (c *AggregatorGRpcClient) InitOperatorToAggregator() error {
-	conn, err := grpc.Dial(
-		c.gRPCAggregatorIpPortAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
-	if err != nil {
-		return fmt.Errorf("dial initOperatorToAggregator connection failed: %v", err.Error())
+func aggregatorRequestTimeout(c config.NodeConfig) time.Duration {
+	if c.AggregatorRequestTimeout <= 0 {
+		return defaultAggregatorRequestTimeout
+	}
+
+	return c.AggregatorRequestTimeout
+}
+
+// aggregatorGRPCCredentials returns the insecure credentials if the TLS disabled,
+// the client certificate is used for mTLS if set.
+func aggregatorGRPCCredentials(c config.AggregatorGRPCTLSConfig) (credentials.TransportCredentials, error) {
+	if !c.Enable {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: c.ServerName,
 	}
 
-	n := aggregator.NewAggregatorClient(conn)
-	nodeCtx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	if c.CaPath != "" {
+		caPem, err := os.ReadFile(c.CaPath)
+		if err != nil {
+			return nil, fmt.Errorf("read the ca %s failed: %w", c.CaPath, err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPem) {
+			return nil, fmt.Errorf("no certificate in the ca %s", c.CaPath)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if c.CertPath != "" || c.KeyPath != "" {
+		if c.CertPath == "" || c.KeyPath == "" {
+			return nil, fmt.Errorf("the cert_path and key_path should be both set for mTLS")
+		}
+
+		cert, err := tls.LoadX509KeyPair(c.CertPath, c.KeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("load the client certificate failed: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// Close closes the connection to the aggregator.
+func (c *AggregatorGRpcClient) Close() error {
+	return c.conn.Close()
+}
+
+// callWithAuth calls the authenticated method until it not returns unavailable or the request timeout,
+// the call should sign a new auth for each attempt.
+func (c *AggregatorGRpcClient) callWithAuth(ctx context.Context, method string, call func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
+	backoff := aggregatorAuthRetryMinBackoff
+	for {
+		err := call(ctx)
+		if status.Code(err) != codes.Unavailable {
+			return err
+		}
+
+		c.logger.Debug("The aggregator unavailable, retrying", "method", method, "backoff", backoff, "err", err)
+		if sleepContext(ctx, backoff) != nil {
+			return err
+		}
+
+		backoff *= 2
+		if backoff > aggregatorAuthRetryMaxBackoff {
+			backoff = aggregatorAuthRetryMaxBackoff
+		}
+	}
+}
+
+// InitOperatorToAggregator inits the operator to aggregator, so the aggregator can verify its signatures.
+func (c *AggregatorGRpcClient) InitOperatorToAggregator(ctx context.Context) error {
 	request := &aggregator.InitOperatorRequest{
 		Layer1ChainId:              c.config.Layer1ChainId,
 		ChainId:                    c.config.Layer2ChainId,
@@ -70,9 +202,23 @@ func (c *AggregatorGRpcClient) InitOperatorToAggregator() error {
 		RegistryCoordinatorAddr:    c.config.AVSRegistryCoordinatorAddress,
 	}
 
+	authPayload := message.InitOperatorRequest{
+		Layer1ChainId:              c.config.Layer1ChainId,
+		ChainId:                    c.config.Layer2ChainId,
+		OperatorId:                 c.operatorId,
+		OperatorAddress:            c.operatorAddr,
+		OperatorStateRetrieverAddr: c.OperatorStateRetrieverAddr,
+		RegistryCoordinatorAddr:    c.RegistryCoordinatorAddr,
+	}.AuthPayload()
+
 	c.logger.Info("Init operator to aggregator", "req", fmt.Sprintf("%#v", request))
 
-	reply, err := n.InitOperator(nodeCtx, request)
+	var reply *aggregator.InitOperatorResponse
+	err := c.callWithAuth(ctx, "InitOperator", func(ctx context.Context) (err error) {
+		request.Auth = message.NewOperatorAuth(c.blsKeypair, c.operatorId, message.AuthMethodInitOperator, authPayload).ToPbType()
+		reply, err = c.client.InitOperator(ctx, request)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("call initOperatorToAggregator failed: %v", err.Error())
 	}
@@ -86,26 +232,25 @@ func (c *AggregatorGRpcClient) InitOperatorToAggregator() error {
 }
 
 // CreateAlertTaskToAggregator create a new alert task, if had existing, just return current alert task.
-func (c *AggregatorGRpcClient) CreateAlertTaskToAggregator(alertHash [32]byte) (*message.AlertTaskInfo, error) {
-	conn, err := grpc.Dial(
-		c.gRPCAggregatorIpPortAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("dial initOperatorToAggregator connection failed: %v", err.Error())
+func (c *AggregatorGRpcClient) CreateAlertTaskToAggregator(ctx context.Context, alertHash [32]byte) (*message.AlertTaskInfo, error) {
+	req := message.CreateTaskRequest{
+		AlertHash:     alertHash,
+		RollupChainId: c.config.Layer2ChainId,
 	}
 
-	n := aggregator.NewAggregatorClient(conn)
-	nodeCtx, cancel := context.WithTimeout(context.Background(), c.timeout)
-	defer cancel()
-
 	request := &aggregator.CreateTaskRequest{
-		AlertHash: alertHash[:],
+		AlertHash:     alertHash[:],
+		RollupChainId: req.RollupChainId,
 	}
 
 	c.logger.Info("CreateAlertTask to aggregator", "req", fmt.Sprintf("%#v", request))
 
-	reply, err := n.CreateTask(nodeCtx, request)
+	var reply *aggregator.CreateTaskResponse
+	err := c.callWithAuth(ctx, "CreateTask", func(ctx context.Context) (err error) {
+		request.Auth = message.NewOperatorAuth(c.blsKeypair, c.operatorId, message.AuthMethodCreateTask, req.AuthPayload()).ToPbType()
+		reply, err = c.client.CreateTask(ctx, request)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("call CreateAlertTask failed: %v", err.Error())
 	}
@@ -119,27 +264,18 @@ func (c *AggregatorGRpcClient) CreateAlertTaskToAggregator(alertHash [32]byte) (
 }
 
 // SendSignedTaskResponseToAggregator sends a signed task response to the aggregator.
-// it is meant to be ran inside a go thread, so doesn't return anything.
-// this is because sending the signed task response to the aggregator is time sensitive,
-// so there is no point in retrying if it fails for a few times.
-// Currently hardcoded to retry sending the signed task response 5 times, waiting 2 seconds in between each attempt.
-func (c *AggregatorGRpcClient) SendSignedTaskResponseToAggregator(signedTaskResponse *message.SignedTaskRespRequest, resChan chan alert.AlertResponse) {
-	conn, err := grpc.Dial(
-		c.gRPCAggregatorIpPortAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+// it is meant to be ran inside a go thread, so doesn't return anything, the result is sent to the resChan.
+// The call is retried with a new auth if the aggregator unavailable.
+func (c *AggregatorGRpcClient) SendSignedTaskResponseToAggregator(ctx context.Context, signedTaskResponse *message.SignedTaskRespRequest, resChan chan alert.AlertResponse) {
+	authPayload, err := signedTaskResponse.AuthPayload()
 	if err != nil {
 		resChan <- alert.AlertResponse{
 			Err: err,
-			Msg: "dial initOperatorToAggregator connection failed",
+			Msg: "hash the signed task response failed",
 		}
 		return
 	}
 
-	n := aggregator.NewAggregatorClient(conn)
-	nodeCtx, cancel := context.WithTimeout(context.Background(), c.timeout)
-	defer cancel()
-
 	request := &aggregator.SignedTaskRespRequest{
 		Alert:                    signedTaskResponse.Alert.ToPbType(),
 		OperatorRequestSignature: signedTaskResponse.BlsSignature.Serialize(),
@@ -148,11 +284,17 @@ func (c *AggregatorGRpcClient) SendSignedTaskResponseToAggregator(signedTaskResp
 
 	c.logger.Info("CreateAlertTask to aggregator", "req", fmt.Sprintf("%#v", request))
 
-	response, err := n.ProcessSignedTaskResponse(nodeCtx, request)
+	var response *aggregator.SignedTaskRespResponse
+	err = c.callWithAuth(ctx, "ProcessSignedTaskResponse", func(ctx context.Context) (err error) {
+		request.Auth = message.NewOperatorAuth(c.blsKeypair, c.operatorId, message.AuthMethodProcessSignedTaskResponse, authPayload).ToPbType()
+		response, err = c.client.ProcessSignedTaskResponse(ctx, request)
+		return err
+	})
 	if err != nil {
 		resChan <- alert.AlertResponse{
-			Err: err,
-			Msg: fmt.Sprintf("call CreateAlertTask failed by %v", err),
+			Code: aggregatorErrorCode(err),
+			Err:  err,
+			Msg:  fmt.Sprintf("call CreateAlertTask failed by %v", err),
 		}
 		return
 	}
@@ -168,5 +310,17 @@ func (c *AggregatorGRpcClient) SendSignedTaskResponseToAggregator(signedTaskResp
 	c.logger.Info("Signed task resp", "response", res)
 	c.metrics.IncNumTasksAcceptedByAggregator()
 
+	waitTaskSubmitted(ctx, c.logger, c.config.WaitTaskSubmittedTimeout, func(ctx context.Context, taskIndex types.TaskIndex) (*message.TaskStatus, error) {
+		ctx, cancel := context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+
+		status, err := c.client.GetTaskStatus(ctx, &aggregator.GetTaskStatusRequest{TaskIndex: taskIndex})
+		if err != nil {
+			return nil, err
+		}
+
+		return message.NewTaskStatus(status)
+	}, &res)
+
 	resChan <- res
 }
@@ -6,9 +6,11 @@ import (
 	"net/rpc"
 	"time"
 
+	"github.com/Layr-Labs/eigensdk-go/crypto/bls"
 	"github.com/Layr-Labs/eigensdk-go/logging"
 	sdktypes "github.com/Layr-Labs/eigensdk-go/types"
 	aggRpc "github.com/alt-research/avs/legacy/aggregator/rpc"
+	"github.com/alt-research/avs/legacy/aggregator/types"
 	"github.com/alt-research/avs/legacy/api/grpc/aggregator"
 	"github.com/alt-research/avs/legacy/core/alert"
 	"github.com/alt-research/avs/legacy/core/config"
@@ -25,6 +27,7 @@ type AggregatorJsonRpcClient struct {
 	logger                      logging.Logger
 	config                      config.NodeConfig
 	operatorId                  sdktypes.OperatorId
+	blsKeypair                  *bls.KeyPair
 	operatorAddr                common.Address
 	OperatorStateRetrieverAddr  common.Address
 	RegistryCoordinatorAddr     common.Address
@@ -32,7 +35,7 @@ type AggregatorJsonRpcClient struct {
 	timeout                     time.Duration
 }
 
-func NewAggregatorJsonRpcClient(config config.NodeConfig, operatorId sdktypes.OperatorId, operatorAddr common.Address, logger logging.Logger, metrics metrics.Metrics) (*AggregatorJsonRpcClient, error) {
+func NewAggregatorJsonRpcClient(config config.NodeConfig, operatorId sdktypes.OperatorId, blsKeypair *bls.KeyPair, operatorAddr common.Address, logger logging.Logger, metrics metrics.Metrics) (*AggregatorJsonRpcClient, error) {
 	return &AggregatorJsonRpcClient{
 		// set to nil so that we can create an rpc client even if the aggregator is not running
 		rpcClient:                   nil,
@@ -40,31 +43,47 @@ func NewAggregatorJsonRpcClient(config config.NodeConfig, operatorId sdktypes.Op
 		logger:                      logger,
 		config:                      config,
 		operatorId:                  operatorId,
+		blsKeypair:                  blsKeypair,
 		operatorAddr:                operatorAddr,
 		OperatorStateRetrieverAddr:  common.HexToAddress(config.OperatorStateRetrieverAddress),
 		RegistryCoordinatorAddr:     common.HexToAddress(config.AVSRegistryCoordinatorAddress),
 		jsonRPCAggregatorIpPortAddr: config.AggregatorJSONRPCServerIpPortAddr,
-		timeout:                     1 * time.Second,
+		timeout:                     aggregatorRequestTimeout(config),
 	}, nil
 }
 
 // CreateAlertTaskToAggregator create a new alert task, if had existing, just return current alert task.
-func (c *AggregatorJsonRpcClient) InitOperatorToAggregator() error {
-	client, err := gethrpc.DialContext(context.Background(), c.jsonRPCAggregatorIpPortAddr)
+func (c *AggregatorJsonRpcClient) InitOperatorToAggregator(ctx context.Context) error {
+	client, err := gethrpc.DialContext(ctx, c.jsonRPCAggregatorIpPortAddr)
 	if err != nil {
 		return fmt.Errorf("dial initOperatorToAggregator connection failed: %v", err.Error())
 	}
+	defer client.Close()
+
+	authPayload := message.InitOperatorRequest{
+		Layer1ChainId:              c.config.Layer1ChainId,
+		ChainId:                    c.config.Layer2ChainId,
+		OperatorId:                 c.operatorId,
+		OperatorAddress:            c.operatorAddr,
+		OperatorStateRetrieverAddr: c.OperatorStateRetrieverAddr,
+		RegistryCoordinatorAddr:    c.RegistryCoordinatorAddr,
+	}.AuthPayload()
+	auth := message.NewOperatorAuth(c.blsKeypair, c.operatorId, message.AuthMethodInitOperator, authPayload)
 
 	var res aggRpc.InitOperatorResponse
 
+	callCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
 	err = client.CallContext(
-		context.Background(), &res, "aggregator_initOperator",
+		callCtx, &res, "aggregator_initOperator",
 		c.config.Layer1ChainId,
 		c.config.Layer2ChainId,
 		hexutil.Bytes(c.operatorId[:]),
 		c.operatorAddr.Hex(),
 		c.config.OperatorStateRetrieverAddress,
 		c.config.AVSRegistryCoordinatorAddress,
+		newJsonRpcOperatorAuth(auth),
 	)
 	if err != nil {
 		return fmt.Errorf("call initOperatorToAggregator failed: %v", err.Error())
@@ -79,16 +98,27 @@ func (c *AggregatorJsonRpcClient) InitOperatorToAggregator() error {
 }
 
 // CreateAlertTaskToAggregator create a new alert task, if had existing, just return current alert task.
-func (c *AggregatorJsonRpcClient) CreateAlertTaskToAggregator(alertHash [32]byte) (*message.AlertTaskInfo, error) {
-	client, err := gethrpc.DialContext(context.Background(), c.jsonRPCAggregatorIpPortAddr)
+func (c *AggregatorJsonRpcClient) CreateAlertTaskToAggregator(ctx context.Context, alertHash [32]byte) (*message.AlertTaskInfo, error) {
+	client, err := gethrpc.DialContext(ctx, c.jsonRPCAggregatorIpPortAddr)
 	if err != nil {
 		return nil, fmt.Errorf("dial CreateAlertTask connection failed: %v", err.Error())
 	}
+	defer client.Close()
+
+	req := message.CreateTaskRequest{
+		AlertHash:     alertHash,
+		RollupChainId: c.config.Layer2ChainId,
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
 
 	var res aggRpc.AlertTaskInfo
 	err = client.CallContext(
-		context.Background(), &res, "aggregator_createTask",
+		callCtx, &res, "aggregator_createTask",
 		hexutil.Bytes(alertHash[:]),
+		newJsonRpcOperatorAuth(message.NewOperatorAuth(c.blsKeypair, c.operatorId, message.AuthMethodCreateTask, req.AuthPayload())),
+		req.RollupChainId,
 	)
 
 	if err != nil {
@@ -101,6 +131,7 @@ func (c *AggregatorJsonRpcClient) CreateAlertTaskToAggregator(alertHash [32]byte
 		QuorumThresholdPercentages: res.QuorumThresholdPercentages,
 		TaskIndex:                  res.TaskIndex,
 		ReferenceBlockNumber:       res.ReferenceBlockNumber,
+		RollupChainId:              res.RollupChainId,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("call CreateAlertTask failed by decode alert info: %v", err.Error())
@@ -114,8 +145,8 @@ func (c *AggregatorJsonRpcClient) CreateAlertTaskToAggregator(alertHash [32]byte
 // this is because sending the signed task response to the aggregator is time sensitive,
 // so there is no point in retrying if it fails for a few times.
 // Currently hardcoded to retry sending the signed task response 5 times, waiting 2 seconds in between each attempt.
-func (c *AggregatorJsonRpcClient) SendSignedTaskResponseToAggregator(signedTaskResponse *message.SignedTaskRespRequest, resChan chan alert.AlertResponse) {
-	client, err := gethrpc.DialContext(context.Background(), c.jsonRPCAggregatorIpPortAddr)
+func (c *AggregatorJsonRpcClient) SendSignedTaskResponseToAggregator(ctx context.Context, signedTaskResponse *message.SignedTaskRespRequest, resChan chan alert.AlertResponse) {
+	client, err := gethrpc.DialContext(ctx, c.jsonRPCAggregatorIpPortAddr)
 	if err != nil {
 		resChan <- alert.AlertResponse{
 			Err: err,
@@ -123,6 +154,7 @@ func (c *AggregatorJsonRpcClient) SendSignedTaskResponseToAggregator(signedTaskR
 		}
 		return
 	}
+	defer client.Close()
 
 	alertData := signedTaskResponse.Alert.ToPbType()
 	alertDataReq := aggRpc.AlertTaskInfo{
@@ -131,20 +163,36 @@ func (c *AggregatorJsonRpcClient) SendSignedTaskResponseToAggregator(signedTaskR
 		QuorumThresholdPercentages: alertData.QuorumThresholdPercentages,
 		TaskIndex:                  alertData.TaskIndex,
 		ReferenceBlockNumber:       alertData.ReferenceBlockNumber,
+		RollupChainId:              alertData.RollupChainId,
 	}
 	qperatorRequestSignature := signedTaskResponse.BlsSignature.Serialize()
 
+	authPayload, err := signedTaskResponse.AuthPayload()
+	if err != nil {
+		resChan <- alert.AlertResponse{
+			Err: err,
+			Msg: "hash the signed task response failed",
+		}
+		return
+	}
+	auth := message.NewOperatorAuth(c.blsKeypair, c.operatorId, message.AuthMethodProcessSignedTaskResponse, authPayload)
+
 	c.logger.Info("CreateAlertTask to aggregator", "alert", fmt.Sprintf("%#v", alertDataReq))
 
+	callCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
 	var resp aggRpc.SignedTaskRespResponse
 	err = client.CallContext(
-		context.Background(), &resp, "aggregator_processSignedTaskResponse",
+		callCtx, &resp, "aggregator_processSignedTaskResponse",
 		alertDataReq, hexutil.Bytes(qperatorRequestSignature), hexutil.Bytes(signedTaskResponse.OperatorId[:]),
+		newJsonRpcOperatorAuth(auth),
 	)
 	if err != nil {
 		resChan <- alert.AlertResponse{
-			Err: err,
-			Msg: "call CreateAlertTask failed",
+			Code: aggregatorErrorCode(err),
+			Err:  err,
+			Msg:  "call CreateAlertTask failed",
 		}
 		return
 	}
@@ -160,5 +208,42 @@ func (c *AggregatorJsonRpcClient) SendSignedTaskResponseToAggregator(signedTaskR
 	c.logger.Info("Signed task resp", "response", res)
 	c.metrics.IncNumTasksAcceptedByAggregator()
 
+	waitTaskSubmitted(ctx, c.logger, c.config.WaitTaskSubmittedTimeout, func(ctx context.Context, taskIndex types.TaskIndex) (*message.TaskStatus, error) {
+		ctx, cancel := context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+
+		var status aggRpc.TaskStatus
+		err := client.CallContext(ctx, &status, "aggregator_getTaskStatus", nil, taskIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		return message.NewTaskStatus(&aggregator.TaskStatus{
+			Info: &aggregator.AlertTaskInfo{
+				AlertHash:                  status.Info.AlertHash,
+				QuorumNumbers:              status.Info.QuorumNumbers,
+				QuorumThresholdPercentages: status.Info.QuorumThresholdPercentages,
+				TaskIndex:                  status.Info.TaskIndex,
+				ReferenceBlockNumber:       status.Info.ReferenceBlockNumber,
+				RollupChainId:              status.Info.RollupChainId,
+			},
+			State:                  status.State,
+			Reason:                 status.Reason,
+			SignerCount:            status.SignerCount,
+			SignedStakePercentages: status.SignedStakePercentages,
+			TxHash:                 status.TxHash,
+			BlockNumber:            status.BlockNumber,
+			UpdatedAt:              status.UpdatedAt,
+		})
+	}, &res)
+
 	resChan <- res
 }
+
+func newJsonRpcOperatorAuth(auth *message.OperatorAuth) *aggRpc.OperatorAuth {
+	return &aggRpc.OperatorAuth{
+		OperatorId: auth.OperatorId[:],
+		Timestamp:  auth.Timestamp,
+		Signature:  auth.Signature.Serialize(),
+	}
+}
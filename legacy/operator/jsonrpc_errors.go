@@ -0,0 +1,58 @@
+package operator
+
+import (
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+// The standard JSON-RPC 2.0 error codes.
+const (
+	// the body is not a valid json
+	codeParseError int64 = -32700
+	// the json is not a valid request object, or the batch is empty
+	codeInvalidRequest int64 = -32600
+	// the method not exist or not available
+	codeMethodNotFound int64 = -32601
+	// the params can not be decoded or are invalid for the method
+	codeInvalidParams int64 = -32602
+	// the server failed to build the response
+	codeInternalError int64 = -32603
+	// the alert failed without an application error code, such as the aggregator unreachable,
+	// in the implementation-defined server error range [-32099, -32000].
+	codeServerError int64 = -32000
+)
+
+// The application error codes returned by the operator JSON-RPC server:
+//
+//   - [1000, 2000): the alert rejected by aggregator, see `message.AggregatorErrorCode`,
+//     such as `1009` for the alert already finished.
+//   - [2000, 3000): the alert or the task refused to sign by the operator, see `verify_task.go`.
+//   - [3000, 4000): the errors of the alert lifecycle in the operator.
+const (
+	// the task expired or failed in aggregator after the signature accepted
+	codeTaskNotConfirmed uint32 = 3001
+	// querying the status of the alert not signed by the operator
+	codeAlertNotFound uint32 = 3002
+	// the alert not processed before the `alert_timeout`
+	codeAlertTimeout uint32 = 3003
+	// the signed task response not accepted by aggregator before the deadline block of the task
+	codeOutboxExpired uint32 = 3004
+	// the aggregator which created the task unknown, such as its endpoint removed from `aggregator_endpoints`
+	codeTaskAggregatorUnknown uint32 = 3005
+)
+
+func newRPCError(code int64, err error) *jsonrpc2.Error {
+	return &jsonrpc2.Error{
+		Code:    code,
+		Message: err.Error(),
+	}
+}
+
+// alertRPCError converts the failed alert response to the JSON-RPC error,
+// the response without application code is a server error.
+func alertRPCError(code uint32, err error) *jsonrpc2.Error {
+	if code == 0 {
+		return newRPCError(codeServerError, err)
+	}
+
+	return newRPCError(int64(code), err)
+}
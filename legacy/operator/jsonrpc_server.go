@@ -1,9 +1,12 @@
 package operator
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -14,10 +17,21 @@ import (
 	"github.com/sourcegraph/jsonrpc2"
 )
 
+const (
+	// the max count of the notifications and the batched requests handled concurrently,
+	// the notifications exceeded are dropped, and the batched requests wait for the slots.
+	maxConcurrentRequests = 256
+	// the max count of the requests in a batch
+	maxBatchSize = 100
+)
+
 type RpcResponse struct {
 	TaskIndex uint64                  `json:"task_index"`
 	TxHash    alert.HexEncodedBytes32 `json:"tx_hash"`
 	AlertHash alert.HexEncodedBytes32 `json:"alert_hash"`
+	// the block number which the confirm alert tx included, only set if the operator
+	// waited the task confirmed by `wait_task_submitted_timeout`.
+	BlockNumber uint64 `json:"block_number,omitempty"`
 }
 
 type RpcServer struct {
@@ -26,6 +40,14 @@ type RpcServer struct {
 	serverIpPortAddr   string
 	newTaskCreatedChan chan alert.AlertRequest
 	newWorkProofChan   chan message.HealthCheckMsg
+	// the status of the alerts signed by the operator, nil if not tracked
+	alertStatus AlertStatusGetter
+	// the signed task responses not accepted by aggregator, nil if not served
+	outbox OutboxLister
+	// the alert kinds served by the JSON-RPC methods
+	alertKinds *alert.Registry
+	// the slots of the requests handled in goroutines, bounded by `maxConcurrentRequests`
+	requestSlots chan struct{}
 }
 
 // NewRpcServer creates a new rpc server then init the server.
@@ -42,12 +64,32 @@ func NewRpcServer(
 		serverIpPortAddr:   addr,
 		newTaskCreatedChan: taskChain,
 		newWorkProofChan:   newWorkProofChan,
+		alertKinds:         alert.DefaultRegistry,
+		requestSlots:       make(chan struct{}, maxConcurrentRequests),
 	}
 	res.SetHandler(res.setupHandlers())
 
 	return res
 }
 
+// WithAlertStatusGetter returns the server which serves the `alert_getStatus` by the getter,
+// the handler is rebuilt, so should be called before setting the custom handler.
+func (s RpcServer) WithAlertStatusGetter(getter AlertStatusGetter) RpcServer {
+	s.alertStatus = getter
+	s.SetHandler(s.setupHandlers())
+
+	return s
+}
+
+// WithOutboxLister returns the server which serves the `outbox_getEntries` by the lister,
+// the handler is rebuilt, so should be called before setting the custom handler.
+func (s RpcServer) WithOutboxLister(lister OutboxLister) RpcServer {
+	s.outbox = lister
+	s.SetHandler(s.setupHandlers())
+
+	return s
+}
+
 func (s *RpcServer) SetHandler(handler http.Handler) {
 	s.server.Handler = handler
 }
@@ -83,169 +125,315 @@ func (s *RpcServer) setupHandlers() http.Handler {
 	return mux
 }
 
+// rpcResponse is the JSON-RPC 2.0 response object, the id is null if it can not be read from the request.
+type rpcResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      *jsonrpc2.ID    `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *jsonrpc2.Error `json:"error,omitempty"`
+}
+
+func newRPCErrorResponse(id *jsonrpc2.ID, rpcErr *jsonrpc2.Error) *rpcResponse {
+	return &rpcResponse{
+		Version: "2.0",
+		ID:      id,
+		Error:   rpcErr,
+	}
+}
+
 func (s *RpcServer) HttpRPCHandler(w http.ResponseWriter, r *http.Request) {
-	rpcRequest := jsonrpc2.Request{}
-	err := json.NewDecoder(r.Body).Decode(&rpcRequest)
+	s.ServeRPCByAVS("", w, r)
+}
+
+// ServeRPCByAVS serves the JSON-RPC 2.0 request or batch in the http body for the avs,
+// the notifications are dispatched in background without response, or dropped if too many in processing.
+func (s *RpcServer) ServeRPCByAVS(avsName string, w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
 	if err != nil {
-		WriteErrorJSON(s.logger, w, rpcRequest.ID, http.StatusBadRequest, 1, err)
+		writeRPCResponse(s.logger, w, newRPCErrorResponse(nil, newRPCError(codeParseError, err)))
 		return
 	}
 
-	if rpcRequest.Params == nil {
-		err := errors.New("failed to unmarshal request.Params for mevBundle from mev-builder, error: EOF")
-		WriteErrorJSON(s.logger, w, rpcRequest.ID, http.StatusBadRequest, 1, err)
+	var batch []json.RawMessage
+	trimmed := bytes.TrimLeft(body, " \t\r\n")
+	if len(trimmed) == 0 || trimmed[0] != '[' {
+		res := s.handleRawRequest(avsName, body)
+		if res == nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeRPCResponse(s.logger, w, res)
 		return
 	}
 
-	s.HttpRPCHandlerRequest(w, rpcRequest)
-}
+	if err := json.Unmarshal(body, &batch); err != nil {
+		writeRPCResponse(s.logger, w, newRPCErrorResponse(nil, newRPCError(codeParseError, err)))
+		return
+	}
 
-func (s *RpcServer) HttpRPCHandlerRequest(w http.ResponseWriter, rpcRequest jsonrpc2.Request) {
-	s.HttpRPCHandlerRequestByAVS("", w, rpcRequest)
+	if len(batch) == 0 {
+		writeRPCResponse(s.logger, w, newRPCErrorResponse(nil, newRPCError(codeInvalidRequest, errors.New("empty batch"))))
+		return
+	}
+
+	if len(batch) > maxBatchSize {
+		err := errors.Errorf("the batch has %d requests, exceeds the max %d", len(batch), maxBatchSize)
+		writeRPCResponse(s.logger, w, newRPCErrorResponse(nil, newRPCError(codeInvalidRequest, err)))
+		return
+	}
+
+	// the alerts in the batch may wait for the aggregator, so handle them concurrently
+	// in the slots shared with the notifications.
+	results := make([]*rpcResponse, len(batch))
+	var wg sync.WaitGroup
+	for i, raw := range batch {
+		select {
+		case s.requestSlots <- struct{}{}:
+		case <-r.Context().Done():
+			// the client gone, no one will read the responses
+			wg.Wait()
+			return
+		}
+
+		wg.Add(1)
+		go func(i int, raw json.RawMessage) {
+			defer func() {
+				<-s.requestSlots
+				wg.Done()
+			}()
+			results[i] = s.handleRawRequest(avsName, raw)
+		}(i, raw)
+	}
+	wg.Wait()
+
+	responses := make([]*rpcResponse, 0, len(results))
+	for _, res := range results {
+		if res != nil {
+			responses = append(responses, res)
+		}
+	}
+
+	if len(responses) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	writeRPCResponse(s.logger, w, responses)
 }
 
-func unmarshalParams(logger logging.Logger, rpcRequest jsonrpc2.Request, val any) error {
-	var params []json.RawMessage
-	logger.Debug("params", "raw", *rpcRequest.Params)
-	if err := json.Unmarshal(*rpcRequest.Params, &params); err != nil {
-		logger.Error("the unmarshal", "err", err)
-		return errors.Wrap(err, "failed to unmarshal alert bundle params")
+// handleRawRequest decodes the request object then handles it, returns nil for the notification.
+func (s *RpcServer) handleRawRequest(avsName string, raw json.RawMessage) *rpcResponse {
+	var header struct {
+		Version string `json:"jsonrpc"`
+	}
+	if err := json.Unmarshal(raw, &header); err != nil {
+		if _, ok := err.(*json.SyntaxError); ok {
+			return newRPCErrorResponse(nil, newRPCError(codeParseError, err))
+		}
+		return newRPCErrorResponse(nil, newRPCError(codeInvalidRequest, err))
 	}
 
-	if len(params) == 0 {
-		logger.Error("failed to unmarshal params by no msg")
-		return errors.New("failed to unmarshal health check params by no msg")
+	var rpcRequest jsonrpc2.Request
+	if err := json.Unmarshal(raw, &rpcRequest); err != nil {
+		return newRPCErrorResponse(nil, newRPCError(codeInvalidRequest, err))
 	}
 
-	if err := json.Unmarshal(params[0], val); err != nil {
-		logger.Error("the unmarshal", "err", err)
-		return errors.Wrap(err, "failed to unmarshal alert bundle params")
+	if header.Version != "2.0" {
+		err := errors.Errorf("unsupported jsonrpc version %q", header.Version)
+		if rpcRequest.Notif {
+			return nil
+		}
+		return newRPCErrorResponse(&rpcRequest.ID, newRPCError(codeInvalidRequest, err))
 	}
 
-	return nil
+	return s.handleRequest(avsName, rpcRequest)
 }
 
-func (s *RpcServer) HttpRPCHandlerRequestByAVS(avsName string, w http.ResponseWriter, rpcRequest jsonrpc2.Request) {
+// handleRequest calls the method, returns nil for the notification, which is called in background
+// so the client not waits for the alert to be processed, the notification is dropped if no slot.
+func (s *RpcServer) handleRequest(avsName string, rpcRequest jsonrpc2.Request) *rpcResponse {
 	logger := s.logger.With(
 		"avsName", avsName,
 		"rpcMethod", rpcRequest.Method,
 	)
 
-	switch rpcRequest.Method {
-	case "health_check":
-		{
-			var msg message.BlockWorkProof
-			if err := unmarshalParams(logger, rpcRequest, &msg); err != nil {
-				WriteErrorJSON(logger, w, rpcRequest.ID, http.StatusBadRequest, 3, err)
-				return
-			}
+	if rpcRequest.Notif {
+		select {
+		case s.requestSlots <- struct{}{}:
+		default:
+			logger.Warn("too many requests in processing, drop the notification", "max", maxConcurrentRequests)
+			return nil
+		}
 
-			s.logger.Info("on health check", "msg", msg)
+		go func() {
+			defer func() { <-s.requestSlots }()
 
-			s.newWorkProofChan <- message.HealthCheckMsg{
-				AvsName: avsName,
-				Proof:   msg,
+			if _, rpcErr := s.callMethod(logger, avsName, rpcRequest); rpcErr != nil {
+				logger.Warn("the notification failed", "code", rpcErr.Code, "err", rpcErr.Message)
 			}
+		}()
+		return nil
+	}
 
-			WriteJSON(logger, w, rpcRequest.ID, true)
-		}
-	case "alert_blockMismatch":
-		{
-			var alert alert.AlertBlockMismatch
-			if err := unmarshalParams(logger, rpcRequest, &alert); err != nil {
-				WriteErrorJSON(logger, w, rpcRequest.ID, http.StatusBadRequest, 3, err)
-				return
-			}
+	result, rpcErr := s.callMethod(logger, avsName, rpcRequest)
 
-			if alert.AVSName == "" && avsName != "" {
-				alert.AVSName = avsName
-			}
+	if rpcErr != nil {
+		logger.Info("writeErrorJSON", "id", rpcRequest.ID, "code", rpcErr.Code, "err", rpcErr.Message)
+		return newRPCErrorResponse(&rpcRequest.ID, rpcErr)
+	}
 
-			res := s.SendAlert(logger.With("alertType", "AlertBlockMismatch"), &alert)
-			if res.Err != nil {
-				WriteErrorJSON(
-					logger,
-					w, rpcRequest.ID,
-					http.StatusBadRequest, res.Code,
-					errors.Wrap(res.Err, "failed to call alert"),
-				)
-				return
-			}
+	data, err := json.Marshal(result)
+	if err != nil {
+		logger.Errorf("error: failed to marshal json to render the result, error: %v", err)
+		return newRPCErrorResponse(&rpcRequest.ID, newRPCError(codeInternalError, err))
+	}
 
-			response := RpcResponse{
-				TaskIndex: uint64(res.TaskIndex),
-				TxHash:    res.TxHash,
-				AlertHash: alert.MessageHash(),
-			}
+	return &rpcResponse{
+		Version: "2.0",
+		ID:      &rpcRequest.ID,
+		Result:  data,
+	}
+}
+
+// HttpRPCHandlerRequest handles a single decoded request.
+func (s *RpcServer) HttpRPCHandlerRequest(w http.ResponseWriter, rpcRequest jsonrpc2.Request) {
+	s.HttpRPCHandlerRequestByAVS("", w, rpcRequest)
+}
+
+// HttpRPCHandlerRequestByAVS handles a single decoded request for the avs.
+func (s *RpcServer) HttpRPCHandlerRequestByAVS(avsName string, w http.ResponseWriter, rpcRequest jsonrpc2.Request) {
+	res := s.handleRequest(avsName, rpcRequest)
+	if res == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	writeRPCResponse(s.logger, w, res)
+}
+
+// unmarshalParams decodes the first param of the by-position params, or the by-name params as a whole.
+func unmarshalParams(logger logging.Logger, rpcRequest jsonrpc2.Request, val any) *jsonrpc2.Error {
+	if rpcRequest.Params == nil {
+		return newRPCError(codeInvalidParams, errors.New("missing params"))
+	}
 
-			WriteJSON(logger, w, rpcRequest.ID, response)
+	logger.Debug("params", "raw", *rpcRequest.Params)
+
+	param := json.RawMessage(*rpcRequest.Params)
+	if trimmed := bytes.TrimLeft(param, " \t\r\n"); len(trimmed) == 0 || trimmed[0] != '{' {
+		var params []json.RawMessage
+		if err := json.Unmarshal(param, &params); err != nil {
+			logger.Error("the unmarshal", "err", err)
+			return newRPCError(codeInvalidParams, errors.Wrap(err, "failed to unmarshal params"))
 		}
-	case "alert_blockOutputOracleMismatch":
-		{
-			var alert alert.AlertBlockOutputOracleMismatch
-			if err := unmarshalParams(logger, rpcRequest, &alert); err != nil {
-				WriteErrorJSON(logger, w, rpcRequest.ID, http.StatusBadRequest, 3, err)
-				return
-			}
 
-			if alert.AVSName == "" && avsName != "" {
-				alert.AVSName = avsName
-			}
+		if len(params) == 0 {
+			logger.Error("failed to unmarshal params by no msg")
+			return newRPCError(codeInvalidParams, errors.New("failed to unmarshal params by no msg"))
+		}
 
-			res := s.SendAlert(logger.With("alertType", "AlertBlockOutputOracleMismatch"), &alert)
-			if res.Err != nil {
-				WriteErrorJSON(
-					logger,
-					w, rpcRequest.ID, http.StatusBadRequest, res.Code,
-					errors.Wrap(res.Err, "failed to call alert output oracle"),
-				)
-				return
-			}
+		param = params[0]
+	}
 
-			response := RpcResponse{
-				TaskIndex: uint64(res.TaskIndex),
-				TxHash:    res.TxHash,
-				AlertHash: alert.MessageHash(),
-			}
+	if err := json.Unmarshal(param, val); err != nil {
+		logger.Error("the unmarshal", "err", err)
+		return newRPCError(codeInvalidParams, errors.Wrap(err, "failed to unmarshal params"))
+	}
+
+	return nil
+}
 
-			WriteJSON(logger, w, rpcRequest.ID, response)
+// callMethod returns the result of the method, or the JSON-RPC error.
+func (s *RpcServer) callMethod(logger logging.Logger, avsName string, rpcRequest jsonrpc2.Request) (interface{}, *jsonrpc2.Error) {
+	switch rpcRequest.Method {
+	case "health_check":
+		var msg message.BlockWorkProof
+		if err := unmarshalParams(logger, rpcRequest, &msg); err != nil {
+			return nil, err
 		}
-	case "alert_blockHash":
-		{
-			var alert alert.AlertBlockHashMismatch
-			if err := unmarshalParams(logger, rpcRequest, &alert); err != nil {
-				WriteErrorJSON(logger, w, rpcRequest.ID, http.StatusBadRequest, 3, err)
-				return
-			}
 
-			if alert.AVSName == "" && avsName != "" {
-				alert.AVSName = avsName
-			}
+		s.logger.Info("on health check", "msg", msg)
 
-			res := s.SendAlert(logger.With("alertType", "AlertBlockHashMismatch"), &alert)
-			if res.Err != nil {
-				WriteErrorJSON(
-					logger,
-					w, rpcRequest.ID, http.StatusBadRequest,
-					res.Code, errors.Wrap(res.Err, "failed to call alert block hash"),
-				)
-				return
-			}
+		s.newWorkProofChan <- message.HealthCheckMsg{
+			AvsName: avsName,
+			Proof:   msg,
+		}
 
-			response := RpcResponse{
-				TaskIndex: uint64(res.TaskIndex),
-				TxHash:    res.TxHash,
-				AlertHash: alert.MessageHash(),
-			}
+		return true, nil
+	case "alert_getStatus":
+		if s.alertStatus == nil {
+			return nil, newRPCError(codeMethodNotFound, errors.New("the alert status is not tracked"))
+		}
+
+		var alertHash alert.HexEncodedBytes32
+		if err := unmarshalParams(logger, rpcRequest, &alertHash); err != nil {
+			return nil, err
+		}
+
+		record, ok := s.alertStatus.GetAlertStatus(alertHash)
+		if !ok {
+			err := errors.Errorf("the alert 0x%x is not signed by the operator", alertHash[:])
+			return nil, alertRPCError(codeAlertNotFound, err)
+		}
+
+		return record, nil
+	case "outbox_getEntries":
+		if s.outbox == nil {
+			return nil, newRPCError(codeMethodNotFound, errors.New("the outbox is not served"))
+		}
 
-			WriteJSON(logger, w, rpcRequest.ID, response)
+		entries, err := s.outbox.ListOutbox()
+		if err != nil {
+			return nil, newRPCError(codeInternalError, err)
 		}
+
+		return entries, nil
 	default:
-		err := errors.Errorf("got unsupported method name: %v", rpcRequest.Method)
-		WriteErrorJSON(logger, w, rpcRequest.ID, http.StatusNotFound, 1, err)
+		kind, ok := s.alertKinds.Lookup(rpcRequest.Method)
+		if !ok {
+			return nil, newRPCError(codeMethodNotFound, errors.Errorf("got unsupported method name: %v", rpcRequest.Method))
+		}
+
+		return s.callAlert(logger.With("alertType", kind.Name), avsName, kind, rpcRequest)
 	}
 }
 
+// callAlert decodes the alert by its kind, then sends it to the operator.
+func (s *RpcServer) callAlert(logger logging.Logger, avsName string, kind alert.Kind, rpcRequest jsonrpc2.Request) (interface{}, *jsonrpc2.Error) {
+	var param json.RawMessage
+	if err := unmarshalParams(logger, rpcRequest, &param); err != nil {
+		return nil, err
+	}
+
+	alertReq, err := kind.Decode(param)
+	if err != nil {
+		logger.Error("the unmarshal", "err", err)
+		return nil, newRPCError(codeInvalidParams, errors.Wrap(err, "failed to unmarshal alert bundle params"))
+	}
+
+	if kind.Validate != nil {
+		if err := kind.Validate(alertReq); err != nil {
+			return nil, newRPCError(codeInvalidParams, errors.Wrapf(err, "invalid %s", kind.Name))
+		}
+	}
+
+	if setter, ok := alertReq.(alert.AVSNameSetter); ok && alertReq.GetAVSName() == "" && avsName != "" {
+		setter.SetAVSName(avsName)
+	}
+
+	res := s.SendAlert(logger, alertReq)
+	if res.Err != nil {
+		return nil, alertRPCError(res.Code, errors.Wrapf(res.Err, "failed to call %s", rpcRequest.Method))
+	}
+
+	return RpcResponse{
+		TaskIndex:   uint64(res.TaskIndex),
+		TxHash:      res.TxHash,
+		AlertHash:   kind.Hash(alertReq),
+		BlockNumber: res.BlockNumber,
+	}, nil
+}
+
 func (s *RpcServer) SendAlert(logger logging.Logger, alertReq alert.Alert) alert.AlertResponse {
 	logger.Info("Send alert", "alert", alertReq)
 
@@ -269,6 +457,18 @@ func (s *RpcServer) SendAlert(logger logging.Logger, alertReq alert.Alert) alert
 	return response
 }
 
+// writeRPCResponse writes the JSON-RPC response or the batch of responses.
+func writeRPCResponse(logger logging.Logger, w http.ResponseWriter, res interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logger.Errorf("error: failed to marshal json to render the response, error: %v", err)
+	}
+}
+
+// WriteErrorJSON writes the error with the http status and the custom code for a single request,
+// the operator server itself responds the standard JSON-RPC errors.
 func WriteErrorJSON(logger logging.Logger, w http.ResponseWriter, id jsonrpc2.ID, statusCode int, code uint32, err error) {
 	logger.Info("writeErrorJSON", "id", id, "err", err)
 
@@ -0,0 +1,130 @@
+package operator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	sdklogging "github.com/Layr-Labs/eigensdk-go/logging"
+
+	"github.com/alt-research/avs/legacy/core/alert"
+	"github.com/alt-research/avs/legacy/core/message"
+)
+
+func serveTestRPC(s *RpcServer, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	s.HttpRPCHandler(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+
+	return w
+}
+
+func TestRpcServerDropNotifications(t *testing.T) {
+	workProofs := make(chan message.HealthCheckMsg, 1)
+	s := NewRpcServer(sdklogging.NewNoopLogger(), "", make(chan alert.AlertRequest), workProofs)
+
+	// all the slots are taken by the requests in processing
+	for i := 0; i < maxConcurrentRequests; i++ {
+		s.requestSlots <- struct{}{}
+	}
+
+	notification := `{"jsonrpc":"2.0","method":"health_check","params":[{}]}`
+	if w := serveTestRPC(&s, notification); w.Code != http.StatusNoContent {
+		t.Fatalf("expect the status %d for the notification, got %d", http.StatusNoContent, w.Code)
+	}
+
+	// the notification is dropped without starting a goroutine
+	select {
+	case msg := <-workProofs:
+		t.Fatalf("the notification should be dropped, got %+v", msg)
+	default:
+	}
+
+	<-s.requestSlots
+	if w := serveTestRPC(&s, notification); w.Code != http.StatusNoContent {
+		t.Fatalf("expect the status %d for the notification, got %d", http.StatusNoContent, w.Code)
+	}
+
+	select {
+	case <-workProofs:
+	case <-time.After(time.Second):
+		t.Fatalf("the notification should be handled after a slot released")
+	}
+}
+
+func TestRpcServerBatchBounded(t *testing.T) {
+	// the health checks are blocked until the work proofs read
+	workProofs := make(chan message.HealthCheckMsg)
+	s := NewRpcServer(sdklogging.NewNoopLogger(), "", make(chan alert.AlertRequest), workProofs)
+
+	// only 2 slots left for the batch
+	taken := maxConcurrentRequests - 2
+	for i := 0; i < taken; i++ {
+		s.requestSlots <- struct{}{}
+	}
+
+	const count = 4
+	requests := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		requests = append(requests, fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":"health_check","params":[{}]}`, i))
+	}
+
+	done := make(chan *httptest.ResponseRecorder, 1)
+	go func() {
+		done <- serveTestRPC(&s, "["+strings.Join(requests, ",")+"]")
+	}()
+
+	for i := 0; i < count; i++ {
+		select {
+		case <-workProofs:
+		case <-time.After(time.Second):
+			t.Fatalf("the batched request %d should be handled", i)
+		}
+	}
+
+	var w *httptest.ResponseRecorder
+	select {
+	case w = <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("the batch should be responded")
+	}
+
+	var responses []rpcResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &responses); err != nil {
+		t.Fatalf("decode the responses failed: %v", err)
+	}
+	if len(responses) != count {
+		t.Fatalf("expect %d responses, got %d", count, len(responses))
+	}
+	for _, res := range responses {
+		if res.Error != nil {
+			t.Fatalf("the request %s failed: %v", res.ID, res.Error)
+		}
+	}
+
+	if len(s.requestSlots) != taken {
+		t.Fatalf("the slots should be released after the batch, got %d taken", len(s.requestSlots))
+	}
+}
+
+func TestRpcServerBatchTooLarge(t *testing.T) {
+	s := NewRpcServer(sdklogging.NewNoopLogger(), "", make(chan alert.AlertRequest), make(chan message.HealthCheckMsg))
+
+	requests := make([]string, 0, maxBatchSize+1)
+	for i := 0; i <= maxBatchSize; i++ {
+		requests = append(requests, fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":"health_check","params":[{}]}`, i))
+	}
+
+	w := serveTestRPC(&s, "["+strings.Join(requests, ",")+"]")
+
+	var res rpcResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Fatalf("decode the response failed: %v", err)
+	}
+	if res.Error == nil || res.Error.Code != codeInvalidRequest {
+		t.Fatalf("expect the batch rejected by %d, got %+v", codeInvalidRequest, res.Error)
+	}
+}
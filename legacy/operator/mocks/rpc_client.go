@@ -10,6 +10,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	alert "github.com/alt-research/avs/legacy/core/alert"
@@ -41,28 +42,42 @@ func (m *MockAggregatorRpcClienter) EXPECT() *MockAggregatorRpcClienterMockRecor
 }
 
 // CreateAlertTaskToAggregator mocks base method.
-func (m *MockAggregatorRpcClienter) CreateAlertTaskToAggregator(arg0 [32]byte) (*message.AlertTaskInfo, error) {
+func (m *MockAggregatorRpcClienter) CreateAlertTaskToAggregator(arg0 context.Context, arg1 [32]byte) (*message.AlertTaskInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAlertTaskToAggregator", arg0)
+	ret := m.ctrl.Call(m, "CreateAlertTaskToAggregator", arg0, arg1)
 	ret0, _ := ret[0].(*message.AlertTaskInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateAlertTaskToAggregator indicates an expected call of CreateAlertTaskToAggregator.
-func (mr *MockAggregatorRpcClienterMockRecorder) CreateAlertTaskToAggregator(arg0 any) *gomock.Call {
+func (mr *MockAggregatorRpcClienterMockRecorder) CreateAlertTaskToAggregator(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlertTaskToAggregator", reflect.TypeOf((*MockAggregatorRpcClienter)(nil).CreateAlertTaskToAggregator), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlertTaskToAggregator", reflect.TypeOf((*MockAggregatorRpcClienter)(nil).CreateAlertTaskToAggregator), arg0, arg1)
+}
+
+// InitOperatorToAggregator mocks base method.
+func (m *MockAggregatorRpcClienter) InitOperatorToAggregator(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitOperatorToAggregator", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InitOperatorToAggregator indicates an expected call of InitOperatorToAggregator.
+func (mr *MockAggregatorRpcClienterMockRecorder) InitOperatorToAggregator(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitOperatorToAggregator", reflect.TypeOf((*MockAggregatorRpcClienter)(nil).InitOperatorToAggregator), arg0)
 }
 
 // SendSignedTaskResponseToAggregator mocks base method.
-func (m *MockAggregatorRpcClienter) SendSignedTaskResponseToAggregator(arg0 *message.SignedTaskRespRequest, arg1 chan alert.AlertResponse) {
+func (m *MockAggregatorRpcClienter) SendSignedTaskResponseToAggregator(arg0 context.Context, arg1 *message.SignedTaskRespRequest, arg2 chan alert.AlertResponse) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "SendSignedTaskResponseToAggregator", arg0, arg1)
+	m.ctrl.Call(m, "SendSignedTaskResponseToAggregator", arg0, arg1, arg2)
 }
 
 // SendSignedTaskResponseToAggregator indicates an expected call of SendSignedTaskResponseToAggregator.
-func (mr *MockAggregatorRpcClienterMockRecorder) SendSignedTaskResponseToAggregator(arg0, arg1 any) *gomock.Call {
+func (mr *MockAggregatorRpcClienterMockRecorder) SendSignedTaskResponseToAggregator(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendSignedTaskResponseToAggregator", reflect.TypeOf((*MockAggregatorRpcClienter)(nil).SendSignedTaskResponseToAggregator), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendSignedTaskResponseToAggregator", reflect.TypeOf((*MockAggregatorRpcClienter)(nil).SendSignedTaskResponseToAggregator), arg0, arg1, arg2)
 }
@@ -5,6 +5,7 @@ import (
 	"crypto/ecdsa"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"strconv"
@@ -57,6 +58,16 @@ type Operator struct {
 	operatorAddr     common.Address
 	metadataURI      string
 	rpcServer        RpcServer
+	// the alerts signed by the operator with their status
+	alertTracker *alertTracker
+	// index the service manager events for the alert tracker, nil if no ws url configured
+	indexer *chainio.ServiceManagerIndexer
+	// validate the alert before signing, nil if not validate
+	alertValidator AlertValidator
+	// process the alerts concurrently
+	alertPool *alertPool
+	// keep the signed task responses until accepted by aggregator
+	outbox *outbox
 	// receive new tasks in this chan (typically from mach service)
 	newTaskCreatedChan chan alert.AlertRequest
 	newWorkProofChan   chan message.HealthCheckMsg
@@ -85,6 +96,10 @@ func withEnvConfig(c config.NodeConfig) config.NodeConfig {
 	// - `OPERATOR_STATE_RETRIEVER_ADDRESS` : operator_state_retriever_address
 	// - `OPERATOR_SERVER_URL` : operator_server_ip_port_addr
 	// - `METADATA_URI` : metadata_uri
+	// - `ALERT_WEBHOOK_URL` : alert_webhook_url
+	// - `OP_NODE_RPC_URL` : op_node_rpc_url
+	// - `OUTBOX_PATH` : outbox_path
+	// - `INDEXER_CHECKPOINT_PATH` : indexer_checkpoint_path
 
 	Production, ok := os.LookupEnv("OPERATOR_PRODUCTION")
 	if ok && Production != "" {
@@ -196,6 +211,26 @@ func withEnvConfig(c config.NodeConfig) config.NodeConfig {
 		c.OperatorEcdsaAddress = operatorEcdsaAddress
 	}
 
+	alertWebhookUrl, ok := os.LookupEnv("ALERT_WEBHOOK_URL")
+	if ok && alertWebhookUrl != "" {
+		c.AlertWebhookUrl = alertWebhookUrl
+	}
+
+	opNodeRpcUrl, ok := os.LookupEnv("OP_NODE_RPC_URL")
+	if ok && opNodeRpcUrl != "" {
+		c.OpNodeRpcUrl = opNodeRpcUrl
+	}
+
+	outboxPath, ok := os.LookupEnv("OUTBOX_PATH")
+	if ok && outboxPath != "" {
+		c.OutboxPath = outboxPath
+	}
+
+	indexerCheckpointPath, ok := os.LookupEnv("INDEXER_CHECKPOINT_PATH")
+	if ok && indexerCheckpointPath != "" {
+		c.IndexerCheckpointPath = indexerCheckpointPath
+	}
+
 	configJson, err := json.MarshalIndent(c, "", "  ")
 	if err != nil {
 		panic(err)
@@ -373,15 +408,63 @@ func NewOperatorFromConfig(cfg config.NodeConfig, isUseEcdsaKey bool) (*Operator
 		return nil, err
 	}
 
-	aggregatorRpcClient, err := buildAggregatorClient(c, operatorId, operatorAddress, logger, avsAndEigenMetrics)
+	aggregatorRpcClient, err := buildAggregatorClient(c, operatorId, blsKeyPair, operatorAddress, logger, avsAndEigenMetrics)
 	if err != nil {
 		logger.Error("buildAggregatorClient falied", "err", err)
 		return nil, err
 	}
 
+	// the service manager events can only be watched by the ws client
+	var indexer *chainio.ServiceManagerIndexer
+	if c.EthWsUrl != "" {
+		ethWsClient, err := eth.NewClient(c.EthWsUrl)
+		if err != nil {
+			logger.Error("Cannot create ws ethclient", "err", err)
+			return nil, err
+		}
+
+		indexer, err = chainio.BuildServiceManagerIndexer(
+			common.Address{},
+			common.HexToAddress(c.AVSRegistryCoordinatorAddress),
+			common.HexToAddress(c.OperatorStateRetrieverAddress),
+			ethWsClient,
+			chainio.IndexerConfig{
+				StartBlock:        c.IndexerStartBlock,
+				ConfirmationDepth: c.IndexerConfirmationDepth,
+				CheckpointPath:    c.IndexerCheckpointPath,
+			},
+			logger)
+		if err != nil {
+			logger.Error("Cannot create the service manager indexer", "err", err)
+			return nil, err
+		}
+	}
+
+	alertTracker := newAlertTracker(logger, ethRpcClient, indexer, newAlertWebhook(logger, c.AlertWebhookUrl), c.AlertExpiredBlocks)
+
+	alertValidator, err := buildAlertValidator(c, ethRpcClient, logger)
+	if err != nil {
+		logger.Error("Cannot create the alert validator", "err", err)
+		return nil, err
+	}
+
+	outboxStore, err := NewOutboxStore(logger, c.OutboxPath)
+	if err != nil {
+		logger.Error("Cannot create the outbox store", "err", err)
+		return nil, err
+	}
+
+	var outboxNodeApi *nodeapi.NodeApi
+	if c.EnableNodeApi {
+		outboxNodeApi = nodeApi
+	}
+	responseOutbox := newOutbox(logger, outboxStore, aggregatorRpcClient, ethRpcClient, c.AlertExpiredBlocks, alertTracker.OnResponse, outboxNodeApi)
+
 	newTaskCreatedChan := make(chan alert.AlertRequest, 32)
 	newWorkProofChan := make(chan message.HealthCheckMsg, 32)
-	rpcServer := NewRpcServer(logger, c.OperatorServerIpPortAddr, newTaskCreatedChan, newWorkProofChan)
+	rpcServer := NewRpcServer(logger, c.OperatorServerIpPortAddr, newTaskCreatedChan, newWorkProofChan).
+		WithAlertStatusGetter(alertTracker).
+		WithOutboxLister(responseOutbox)
 
 	operator := &Operator{
 		config:                     c,
@@ -395,6 +478,10 @@ func NewOperatorFromConfig(cfg config.NodeConfig, isUseEcdsaKey bool) (*Operator
 		eigenlayerReader:           sdkClients.ElChainReader,
 		eigenlayerWriter:           sdkClients.ElChainWriter,
 		rpcServer:                  rpcServer,
+		alertTracker:               alertTracker,
+		indexer:                    indexer,
+		alertValidator:             alertValidator,
+		outbox:                     responseOutbox,
 		blsKeypair:                 blsKeyPair,
 		operatorAddr:               operatorAddress,
 		aggregatorServerIpPortAddr: c.AggregatorServerIpPortAddress,
@@ -406,6 +493,8 @@ func NewOperatorFromConfig(cfg config.NodeConfig, isUseEcdsaKey bool) (*Operator
 		operatorId:                 operatorId,
 	}
 
+	operator.alertPool = newAlertPool(logger, c.AlertWorkers, c.AlertTimeout, c.WaitTaskSubmittedTimeout, operator.processAlert)
+
 	logger.Info("Operator info",
 		"operatorId", operatorId,
 		"operatorAddr", operatorAddress,
@@ -417,10 +506,51 @@ func NewOperatorFromConfig(cfg config.NodeConfig, isUseEcdsaKey bool) (*Operator
 
 }
 
-func buildAggregatorClient(c config.NodeConfig, operatorId sdktypes.OperatorId, operatorAddr common.Address, logger sdklogging.Logger, metrics metrics.Metrics) (AggregatorRpcClienter, error) {
+// buildAlertValidator returns nil if no op-node configured.
+func buildAlertValidator(c config.NodeConfig, ethClient eth.Client, logger sdklogging.Logger) (AlertValidator, error) {
+	if c.OpNodeRpcUrl == "" {
+		return nil, nil
+	}
+
+	logger.Info("Validate the output root alerts by the op-node", "url", c.OpNodeRpcUrl, "l2OutputOracle", c.L2OutputOracleAddress)
+
+	l2Outputs, err := NewOpNodeOutputRootReader(context.Background(), c.OpNodeRpcUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var oracle L2OutputOracleReader
+	if c.L2OutputOracleAddress != "" {
+		if !common.IsHexAddress(c.L2OutputOracleAddress) {
+			return nil, fmt.Errorf("the l2_output_oracle_address format is not hex address!")
+		}
+
+		oracle, err = NewL2OutputOracleReader(common.HexToAddress(c.L2OutputOracleAddress), ethClient)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		logger.Warn("No l2_output_oracle_address configured, the alert_blockOutputOracleMismatch will not be validated")
+	}
+
+	return NewOpOutputValidator(logger, l2Outputs, oracle), nil
+}
+
+func buildAggregatorClient(c config.NodeConfig, operatorId sdktypes.OperatorId, blsKeypair *bls.KeyPair, operatorAddr common.Address, logger sdklogging.Logger, metrics metrics.Metrics) (AggregatorRpcClienter, error) {
+	if len(c.AggregatorEndpoints) != 0 {
+		logger.Info("Use the aggregator endpoints with failover", "count", len(c.AggregatorEndpoints))
+		cli, err := newFailoverAggregatorClient(c, operatorId, blsKeypair, operatorAddr, logger, metrics)
+		if err != nil {
+			logger.Error("Cannot create the failover aggregator client", "err", err)
+			return nil, err
+		}
+
+		return cli, nil
+	}
+
 	if c.AggregatorJSONRPCServerIpPortAddr != "" {
 		logger.Info("Use json rpc server to connect to the aggregator", "address", c.AggregatorJSONRPCServerIpPortAddr)
-		cli, err := NewAggregatorJsonRpcClient(c, operatorId, operatorAddr, logger, metrics)
+		cli, err := NewAggregatorJsonRpcClient(c, operatorId, blsKeypair, operatorAddr, logger, metrics)
 		if err != nil {
 			logger.Error("Cannot create AggregatorGRpcClient. Is aggregator running?", "err", err)
 			return nil, err
@@ -432,7 +562,7 @@ func buildAggregatorClient(c config.NodeConfig, operatorId sdktypes.OperatorId,
 	if c.AggregatorGRPCServerIpPortAddress != "" {
 		logger.Info("Use grpc server to connect to the aggregator", "address", c.AggregatorGRPCServerIpPortAddress)
 
-		cli, err := NewAggregatorGRpcClient(c, operatorId, operatorAddr, logger, metrics)
+		cli, err := NewAggregatorGRpcClient(c, operatorId, blsKeypair, operatorAddr, logger, metrics)
 		if err != nil {
 			logger.Error("Cannot create AggregatorGRpcClient. Is aggregator running?", "err", err)
 			return nil, err
@@ -442,7 +572,7 @@ func buildAggregatorClient(c config.NodeConfig, operatorId sdktypes.OperatorId,
 	} else {
 		logger.Info("Use legacy rpc server to connect to the aggregator", "address", c.AggregatorServerIpPortAddress)
 
-		cli, err := NewAggregatorRpcClient(c, operatorId, operatorAddr, logger, metrics)
+		cli, err := NewAggregatorRpcClient(c, operatorId, blsKeypair, operatorAddr, logger, metrics)
 		if err != nil {
 			logger.Error("Cannot create AggregatorRpcClient. Is aggregator running?", "err", err)
 			return nil, err
@@ -489,12 +619,27 @@ func (o *Operator) Start(ctx context.Context) error {
 			nodeapi.ServiceStatusInitializing,
 		)
 
+		o.nodeApi.RegisterNewService(
+			ServiceOperatorOutbox,
+			ServiceOperatorOutbox,
+			"operator signed task responses sending to aggregator, down if any failed to send",
+			nodeapi.ServiceStatusUp,
+		)
+
 		o.nodeApi.UpdateHealth(nodeapi.Healthy)
 		o.nodeApi.Start()
 	}
 
+	defer func() {
+		if closer, ok := o.aggregatorRpcClient.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				o.logger.Error("Close the aggregator client failed", "err", err)
+			}
+		}
+	}()
+
 	o.logger.Infof("Init operator to aggregator.")
-	err = o.aggregatorRpcClient.InitOperatorToAggregator()
+	err = o.aggregatorRpcClient.InitOperatorToAggregator(ctx)
 	if err != nil {
 		o.logger.Errorf("Init operator to aggregator failed: %v", err)
 		return err
@@ -538,6 +683,20 @@ func (o *Operator) Start(ctx context.Context) error {
 		}
 	}()
 
+	if failover, ok := o.aggregatorRpcClient.(*failoverAggregatorClient); ok {
+		go failover.Start(ctx)
+	}
+	if o.indexer != nil {
+		go func() {
+			if err := o.indexer.Start(ctx); err != nil {
+				o.logger.Error("The service manager indexer stopped", "err", err)
+			}
+		}()
+	}
+	go o.alertTracker.Start(ctx)
+	go o.outbox.Start(ctx)
+	o.alertPool.Start(ctx)
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -552,38 +711,88 @@ func (o *Operator) Start(ctx context.Context) error {
 		case newTaskCreatedLog := <-o.newTaskCreatedChan:
 			o.logger.Info("newTaskCreatedLog", "new", newTaskCreatedLog.Alert)
 			o.metrics.IncNumTasksReceived()
-			taskResponse, err := o.ProcessNewTaskCreatedLog(newTaskCreatedLog.Alert)
-			if err != nil {
-				o.logger.Error("newTaskCreatedLog failed by new", "err", err)
-				var code uint32
-				if strings.Contains(err.Error(), "already finished") {
-					code = 2
-				}
-				newTaskCreatedLog.ResChan <- alert.AlertResponse{
-					Code: code,
-					Err:  err,
-					Msg:  "ProcessNewTaskCreatedLog failed",
-				}
-				continue
-			}
+			o.alertPool.Submit(ctx, newTaskCreatedLog)
+		}
+	}
+}
 
-			signedTaskResponse, err := o.SignTaskResponse(taskResponse)
-			if err != nil {
-				o.logger.Error("newTaskCreatedLog failed by sign task", "err", err)
-				newTaskCreatedLog.ResChan <- alert.AlertResponse{
-					Err: err,
-					Msg: "SignTaskResponse failed",
-				}
-				continue
+// processAlert validates the alert, creates the task in aggregator, then signs the task and sends the signature
+// to aggregator, returns the response of aggregator or the error if failed or the ctx done.
+func (o *Operator) processAlert(ctx context.Context, newAlert alert.Alert) alert.AlertResponse {
+	if o.alertValidator != nil {
+		if err := o.alertValidator.ValidateAlert(ctx, newAlert); err != nil {
+			o.logger.Error("newTaskCreatedLog failed by validate alert", "err", err)
+			var code uint32
+			if verifyErr, ok := err.(*TaskVerifyError); ok {
+				code = verifyErr.Code
+			}
+			return alert.AlertResponse{
+				Code: code,
+				Err:  err,
+				Msg:  "validate the alert failed",
 			}
-			go o.aggregatorRpcClient.SendSignedTaskResponseToAggregator(signedTaskResponse, newTaskCreatedLog.ResChan)
 		}
 	}
+
+	taskResponse, err := o.ProcessNewTaskCreatedLog(ctx, newAlert)
+	if err != nil {
+		o.logger.Error("newTaskCreatedLog failed by new", "err", err)
+		code := aggregatorErrorCode(err)
+		if code == 0 && strings.Contains(err.Error(), "already finished") {
+			// the legacy aggregator without the error codes
+			code = uint32(message.ErrCodeTaskFinished)
+		}
+		return alert.AlertResponse{
+			Code: code,
+			Err:  err,
+			Msg:  "ProcessNewTaskCreatedLog failed",
+		}
+	}
+
+	if ctx.Err() != nil {
+		return alertTimeoutResponse(ctx.Err())
+	}
+
+	if err := o.verifyTaskInfo(ctx, newAlert.MessageHash(), taskResponse); err != nil {
+		o.logger.Error("newTaskCreatedLog failed by verify task", "err", err)
+		var code uint32
+		if verifyErr, ok := err.(*TaskVerifyError); ok {
+			code = verifyErr.Code
+		}
+		return alert.AlertResponse{
+			Code: code,
+			Err:  err,
+			Msg:  "verify the task from aggregator failed",
+		}
+	}
+
+	signedTaskResponse, err := o.SignTaskResponse(taskResponse)
+	if err != nil {
+		o.logger.Error("newTaskCreatedLog failed by sign task", "err", err)
+		return alert.AlertResponse{
+			Err: err,
+			Msg: "SignTaskResponse failed",
+		}
+	}
+	o.alertTracker.OnSigned(&signedTaskResponse.Alert)
+
+	// the outbox keeps sending the response after the ctx done, and tracks the alert by the final response
+	responseChan, err := o.outbox.Submit(ctx, signedTaskResponse)
+	if err != nil {
+		return alertTimeoutResponse(err)
+	}
+
+	select {
+	case response := <-responseChan:
+		return response
+	case <-ctx.Done():
+		return alertTimeoutResponse(ctx.Err())
+	}
 }
 
 // Takes a NewTaskCreatedLog struct as input and returns a TaskResponseHeader struct.
 // The TaskResponseHeader struct is the struct that is signed and sent to the contract as a task response.
-func (o *Operator) ProcessNewTaskCreatedLog(newAlert alert.Alert) (*message.AlertTaskInfo, error) {
+func (o *Operator) ProcessNewTaskCreatedLog(ctx context.Context, newAlert alert.Alert) (*message.AlertTaskInfo, error) {
 	alertHash := newAlert.MessageHash()
 
 	o.logger.Debug("Received new task", "task", newAlert)
@@ -591,7 +800,7 @@ func (o *Operator) ProcessNewTaskCreatedLog(newAlert alert.Alert) (*message.Aler
 		"alert", alertHash,
 	)
 
-	return o.aggregatorRpcClient.CreateAlertTaskToAggregator(alertHash)
+	return o.aggregatorRpcClient.CreateAlertTaskToAggregator(ctx, alertHash)
 }
 
 func (o *Operator) SignTaskResponse(taskResponse *message.AlertTaskInfo) (*message.SignedTaskRespRequest, error) {
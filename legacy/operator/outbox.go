@@ -0,0 +1,313 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/clients/eth"
+	"github.com/Layr-Labs/eigensdk-go/crypto/bls"
+	sdklogging "github.com/Layr-Labs/eigensdk-go/logging"
+	"github.com/Layr-Labs/eigensdk-go/nodeapi"
+	sdktypes "github.com/Layr-Labs/eigensdk-go/types"
+
+	"github.com/alt-research/avs/legacy/aggregator/types"
+	"github.com/alt-research/avs/legacy/core/alert"
+	"github.com/alt-research/avs/legacy/core/message"
+)
+
+const (
+	// the interval to resend the signed task response after the first failure, doubled by each failure
+	outboxRetryMinInterval = 2 * time.Second
+	outboxRetryMaxInterval = 30 * time.Second
+)
+
+// OutboxEntry is a signed task response not accepted by aggregator yet.
+type OutboxEntry struct {
+	TaskIndex    types.TaskIndex         `json:"task_index"`
+	AlertHash    alert.HexEncodedBytes32 `json:"alert_hash"`
+	Task         message.AlertTaskInfo   `json:"task"`
+	BlsSignature hexutil.Bytes           `json:"bls_signature"`
+	OperatorId   alert.HexEncodedBytes32 `json:"operator_id"`
+	// the aggregator which created the task, only set if using the aggregator endpoints
+	Aggregator string `json:"aggregator,omitempty"`
+	// the layer1 block after which the task expired, the response will not be sent since it
+	DeadlineBlock uint64 `json:"deadline_block"`
+	Attempts      int    `json:"attempts"`
+	// the error of the last attempt, empty if not sent yet
+	LastError string `json:"last_error,omitempty"`
+	CreatedAt int64  `json:"created_at"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+func (e *OutboxEntry) key() outboxKey {
+	return outboxKey{taskIndex: e.TaskIndex, alertHash: e.AlertHash}
+}
+
+func (e *OutboxEntry) signedTaskResponse() *message.SignedTaskRespRequest {
+	return &message.SignedTaskRespRequest{
+		Alert:        e.Task,
+		BlsSignature: bls.Signature{G1Point: bls.NewZeroG1Point().Deserialize(e.BlsSignature)},
+		OperatorId:   sdktypes.OperatorId(e.OperatorId),
+	}
+}
+
+// OutboxLister lists the signed task responses not accepted by aggregator yet.
+type OutboxLister interface {
+	ListOutbox() ([]*OutboxEntry, error)
+}
+
+// outboxJob is the entry being sent, the `resChan` is nil for the entries reloaded from the store.
+type outboxJob struct {
+	entry   *OutboxEntry
+	signed  *message.SignedTaskRespRequest
+	resChan chan alert.AlertResponse
+}
+
+// outbox keeps the signed task responses in the store until accepted or rejected by aggregator,
+// the responses failed to send are retried until the deadline block of the task, and resent after restart.
+type outbox struct {
+	logger sdklogging.Logger
+	store  OutboxStore
+	client AggregatorRpcClienter
+	// the client to get the head block for the deadline of the tasks
+	ethClient     eth.Client
+	expiredBlocks uint64
+	// the backoff interval to resend the response
+	retryMinInterval time.Duration
+	retryMaxInterval time.Duration
+	// called with the final response of each entry
+	onResponse func(alertHash [32]byte, res alert.AlertResponse)
+	// nil if the node api not enabled
+	nodeApi *nodeapi.NodeApi
+
+	jobs chan *outboxJob
+	wg   sync.WaitGroup
+
+	mu sync.Mutex
+	// the entries which the last attempt failed
+	failing map[outboxKey]struct{}
+}
+
+var _ OutboxLister = (*outbox)(nil)
+
+func newOutbox(
+	logger sdklogging.Logger,
+	store OutboxStore,
+	client AggregatorRpcClienter,
+	ethClient eth.Client,
+	expiredBlocks uint64,
+	onResponse func(alertHash [32]byte, res alert.AlertResponse),
+	nodeApi *nodeapi.NodeApi,
+) *outbox {
+	if expiredBlocks == 0 {
+		expiredBlocks = defaultAlertExpiredBlocks
+	}
+
+	return &outbox{
+		logger:           logger,
+		store:            store,
+		client:           client,
+		ethClient:        ethClient,
+		expiredBlocks:    expiredBlocks,
+		retryMinInterval: outboxRetryMinInterval,
+		retryMaxInterval: outboxRetryMaxInterval,
+		onResponse:       onResponse,
+		nodeApi:          nodeApi,
+		jobs:             make(chan *outboxJob, alertQueueSize),
+		failing:          make(map[outboxKey]struct{}),
+	}
+}
+
+// Start resends the entries kept in the store, then sends the submitted responses until the ctx done,
+// the store is closed after all the sending stopped.
+func (o *outbox) Start(ctx context.Context) {
+	entries, err := o.store.ListEntries()
+	if err != nil {
+		o.logger.Error("Load the outbox entries failed", "err", err)
+	}
+
+	if len(entries) != 0 {
+		o.logger.Info("Resend the signed task responses in outbox", "count", len(entries))
+	}
+	pinner, _ := o.client.(AggregatorTaskPinner)
+	for _, entry := range entries {
+		if pinner != nil && entry.Aggregator != "" {
+			pinner.PinTaskAggregator(entry.TaskIndex, entry.AlertHash, entry.Aggregator)
+		}
+		o.send(ctx, &outboxJob{
+			entry:  entry,
+			signed: entry.signedTaskResponse(),
+		})
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			o.wg.Wait()
+			if err := o.store.Close(); err != nil {
+				o.logger.Error("Close the outbox store failed", "err", err)
+			}
+			return
+		case job := <-o.jobs:
+			o.send(ctx, job)
+		}
+	}
+}
+
+// Submit keeps the signed task response in the outbox, then the final response of the aggregator will
+// be sent to the returned channel, returns error only if the ctx done before the response queued.
+func (o *outbox) Submit(ctx context.Context, signed *message.SignedTaskRespRequest) (<-chan alert.AlertResponse, error) {
+	now := time.Now().Unix()
+	entry := &OutboxEntry{
+		TaskIndex:     signed.Alert.TaskIndex,
+		AlertHash:     alert.HexEncodedBytes32(signed.Alert.AlertHash),
+		Task:          signed.Alert,
+		BlsSignature:  signed.BlsSignature.Serialize(),
+		OperatorId:    alert.HexEncodedBytes32(signed.OperatorId),
+		DeadlineBlock: signed.Alert.ReferenceBlockNumber + o.expiredBlocks,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	if pinner, ok := o.client.(AggregatorTaskPinner); ok {
+		entry.Aggregator, _ = pinner.TaskAggregator(entry.TaskIndex, entry.AlertHash)
+	}
+
+	// still send the response if failed to keep it, it just will be lost if the operator restart
+	if err := o.store.PutEntry(entry); err != nil {
+		o.logger.Error("Keep the signed task response in outbox failed", "taskIndex", entry.TaskIndex, "err", err)
+	}
+
+	job := &outboxJob{
+		entry:   entry,
+		signed:  signed,
+		resChan: make(chan alert.AlertResponse, 1),
+	}
+
+	select {
+	case o.jobs <- job:
+		return job.resChan, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (o *outbox) ListOutbox() ([]*OutboxEntry, error) {
+	return o.store.ListEntries()
+}
+
+func (o *outbox) send(ctx context.Context, job *outboxJob) {
+	o.wg.Add(1)
+	go func() {
+		defer o.wg.Done()
+		o.deliver(ctx, job)
+	}()
+}
+
+// deliver sends the response until the aggregator replied with a result, or the deadline block passed,
+// the entry is kept in the store if the ctx done, so it will be resent after restart.
+func (o *outbox) deliver(ctx context.Context, job *outboxJob) {
+	entry := job.entry
+	logger := o.logger.With("taskIndex", entry.TaskIndex, "alert", common.Hash(entry.AlertHash))
+	interval := o.retryMinInterval
+
+	for {
+		resChan := make(chan alert.AlertResponse, 1)
+		go o.client.SendSignedTaskResponseToAggregator(ctx, job.signed, resChan)
+
+		var res alert.AlertResponse
+		select {
+		case <-ctx.Done():
+			return
+		case res = <-resChan:
+		}
+
+		// the aggregator replied with its result or a lifecycle error code
+		if res.Err == nil || res.Code != 0 {
+			o.finish(job, res)
+			return
+		}
+
+		entry.Attempts += 1
+		entry.LastError = res.Err.Error()
+		entry.UpdatedAt = time.Now().Unix()
+		if err := o.store.PutEntry(entry); err != nil {
+			logger.Error("Update the outbox entry failed", "err", err)
+		}
+		o.setFailing(entry.key(), true)
+
+		head, err := o.ethClient.BlockNumber(ctx)
+		if err != nil {
+			logger.Warn("Get the head block for the outbox entry failed", "err", err)
+		} else if head >= entry.DeadlineBlock {
+			logger.Error("The signed task response not accepted by aggregator before the deadline",
+				"deadline", entry.DeadlineBlock, "head", head, "attempts", entry.Attempts, "err", res.Err)
+			o.finish(job, alert.AlertResponse{
+				Code:      codeOutboxExpired,
+				Err:       fmt.Errorf("the signed task response not accepted by aggregator before block %d: %w", entry.DeadlineBlock, res.Err),
+				Msg:       "send the signed task response to aggregator failed",
+				TaskIndex: entry.TaskIndex,
+			})
+			return
+		}
+
+		logger.Warn("Send the signed task response failed, retrying",
+			"attempts", entry.Attempts, "interval", interval, "err", res.Err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+
+		interval *= 2
+		if interval > o.retryMaxInterval {
+			interval = o.retryMaxInterval
+		}
+	}
+}
+
+// finish removes the entry from the outbox, then sends the final response.
+func (o *outbox) finish(job *outboxJob, res alert.AlertResponse) {
+	if err := o.store.DeleteEntry(job.entry.TaskIndex, job.entry.AlertHash); err != nil {
+		o.logger.Error("Delete the outbox entry failed", "taskIndex", job.entry.TaskIndex, "err", err)
+	}
+	o.setFailing(job.entry.key(), false)
+
+	if o.onResponse != nil {
+		o.onResponse(job.entry.AlertHash, res)
+	}
+
+	if job.resChan != nil {
+		job.resChan <- res
+	}
+}
+
+// setFailing marks the entry failed or not, the outbox service in the node api is down if any entry failing.
+func (o *outbox) setFailing(key outboxKey, failing bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if failing {
+		o.failing[key] = struct{}{}
+	} else {
+		delete(o.failing, key)
+	}
+
+	if o.nodeApi == nil {
+		return
+	}
+
+	status := nodeapi.ServiceStatusUp
+	if len(o.failing) != 0 {
+		status = nodeapi.ServiceStatusDown
+	}
+	if err := o.nodeApi.UpdateServiceStatus(ServiceOperatorOutbox, status); err != nil {
+		o.logger.Debug("Update the outbox service status failed", "err", err)
+	}
+}
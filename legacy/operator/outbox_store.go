@@ -0,0 +1,168 @@
+package operator
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	sdklogging "github.com/Layr-Labs/eigensdk-go/logging"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb/pebble"
+
+	"github.com/alt-research/avs/legacy/aggregator/types"
+)
+
+const (
+	outboxPebbleCacheSize = 16 // MB
+	outboxPebbleHandles   = 16
+	outboxPebbleNamespace = "mach/operator/outbox/"
+)
+
+var outboxEntryPrefix = []byte("outbox/")
+
+// outboxKey identifies the signed response, the same alert can be signed for different tasks.
+type outboxKey struct {
+	taskIndex types.TaskIndex
+	alertHash [32]byte
+}
+
+// OutboxStore keeps the signed task responses not accepted by aggregator yet,
+// the getters return the entries order by the task index.
+type OutboxStore interface {
+	// PutEntry saves the entry, the entry with the same task index and alert hash will be replaced.
+	PutEntry(entry *OutboxEntry) error
+	DeleteEntry(taskIndex types.TaskIndex, alertHash [32]byte) error
+	ListEntries() ([]*OutboxEntry, error)
+
+	Close() error
+}
+
+// NewOutboxStore creates the outbox store, if the path is empty, will use the in-memory store,
+// else will use a pebble db in the path.
+func NewOutboxStore(logger sdklogging.Logger, path string) (OutboxStore, error) {
+	if path == "" {
+		logger.Warn("outbox path not set, the signed task responses not sent will be lost when the operator restart")
+		return NewMemoryOutboxStore(), nil
+	}
+
+	logger.Info("use pebble outbox store", "path", path)
+	return NewPebbleOutboxStore(path)
+}
+
+// MemoryOutboxStore keeps the entries in memory, will lost all when exit.
+type MemoryOutboxStore struct {
+	mu      sync.RWMutex
+	entries map[outboxKey]*OutboxEntry
+}
+
+var _ OutboxStore = (*MemoryOutboxStore)(nil)
+
+func NewMemoryOutboxStore() *MemoryOutboxStore {
+	return &MemoryOutboxStore{
+		entries: make(map[outboxKey]*OutboxEntry),
+	}
+}
+
+func (s *MemoryOutboxStore) PutEntry(entry *OutboxEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// keep a copy, the entry will be updated by the outbox when retrying
+	copied := *entry
+	s.entries[outboxKey{taskIndex: entry.TaskIndex, alertHash: entry.AlertHash}] = &copied
+
+	return nil
+}
+
+func (s *MemoryOutboxStore) DeleteEntry(taskIndex types.TaskIndex, alertHash [32]byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, outboxKey{taskIndex: taskIndex, alertHash: alertHash})
+
+	return nil
+}
+
+func (s *MemoryOutboxStore) ListEntries() ([]*OutboxEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	res := make([]*OutboxEntry, 0, len(s.entries))
+	for _, entry := range s.entries {
+		copied := *entry
+		res = append(res, &copied)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].TaskIndex < res[j].TaskIndex
+	})
+
+	return res, nil
+}
+
+func (s *MemoryOutboxStore) Close() error {
+	return nil
+}
+
+// PebbleOutboxStore keeps the entries in a pebble db, so the signed task responses can be sent after restart.
+//
+// The datas layout:
+//
+//	outbox/<taskIndex><alertHash> -> json of OutboxEntry
+type PebbleOutboxStore struct {
+	db *pebble.Database
+}
+
+var _ OutboxStore = (*PebbleOutboxStore)(nil)
+
+func NewPebbleOutboxStore(path string) (*PebbleOutboxStore, error) {
+	db, err := pebble.New(path, outboxPebbleCacheSize, outboxPebbleHandles, outboxPebbleNamespace, false, false)
+	if err != nil {
+		return nil, fmt.Errorf("open pebble db %s failed: %w", path, err)
+	}
+
+	return &PebbleOutboxStore{
+		db: db,
+	}, nil
+}
+
+// outboxEntryKey is the key of the entry, the task index is in big endian so the entries order by it.
+func outboxEntryKey(taskIndex types.TaskIndex, alertHash [32]byte) []byte {
+	key := binary.BigEndian.AppendUint32(common.CopyBytes(outboxEntryPrefix), taskIndex)
+	return append(key, alertHash[:]...)
+}
+
+func (s *PebbleOutboxStore) PutEntry(entry *OutboxEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Put(outboxEntryKey(entry.TaskIndex, entry.AlertHash), data)
+}
+
+func (s *PebbleOutboxStore) DeleteEntry(taskIndex types.TaskIndex, alertHash [32]byte) error {
+	return s.db.Delete(outboxEntryKey(taskIndex, alertHash))
+}
+
+func (s *PebbleOutboxStore) ListEntries() ([]*OutboxEntry, error) {
+	it := s.db.NewIterator(outboxEntryPrefix, nil)
+	defer it.Release()
+
+	res := make([]*OutboxEntry, 0)
+	for it.Next() {
+		var entry OutboxEntry
+		if err := json.Unmarshal(it.Value(), &entry); err != nil {
+			return nil, fmt.Errorf("unmarshal %x failed: %w", it.Key(), err)
+		}
+		res = append(res, &entry)
+	}
+
+	return res, it.Error()
+}
+
+func (s *PebbleOutboxStore) Close() error {
+	return s.db.Close()
+}
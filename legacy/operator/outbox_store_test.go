@@ -0,0 +1,129 @@
+package operator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Layr-Labs/eigensdk-go/crypto/bls"
+	sdktypes "github.com/Layr-Labs/eigensdk-go/types"
+
+	"github.com/alt-research/avs/legacy/aggregator/types"
+	"github.com/alt-research/avs/legacy/core/alert"
+	"github.com/alt-research/avs/legacy/core/message"
+)
+
+func newTestOutboxEntry(taskIndex types.TaskIndex, alertHash [32]byte) *OutboxEntry {
+	return &OutboxEntry{
+		TaskIndex: taskIndex,
+		AlertHash: alertHash,
+		Task: message.AlertTaskInfo{
+			AlertHash:                  alertHash,
+			QuorumNumbers:              sdktypes.QuorumNums{0},
+			QuorumThresholdPercentages: sdktypes.QuorumThresholdPercentages{66},
+			TaskIndex:                  taskIndex,
+			ReferenceBlockNumber:       100,
+			RollupChainId:              42,
+		},
+		BlsSignature:  bls.NewZeroSignature().Serialize(),
+		OperatorId:    alert.HexEncodedBytes32{4},
+		Aggregator:    "grpc://aggregator:8190",
+		DeadlineBlock: 200,
+		CreatedAt:     1700000000,
+		UpdatedAt:     1700000000,
+	}
+}
+
+func TestOutboxStore(t *testing.T) {
+	stores := []struct {
+		name string
+		open func(t *testing.T, path string) OutboxStore
+		// if the entries are kept after reopened
+		persistent bool
+	}{
+		{
+			name: "memory",
+			open: func(t *testing.T, path string) OutboxStore {
+				return NewMemoryOutboxStore()
+			},
+		},
+		{
+			name: "pebble",
+			open: func(t *testing.T, path string) OutboxStore {
+				store, err := NewPebbleOutboxStore(path)
+				if err != nil {
+					t.Fatalf("open the pebble outbox store failed: %v", err)
+				}
+				return store
+			},
+			persistent: true,
+		},
+	}
+
+	for _, s := range stores {
+		t.Run(s.name, func(t *testing.T) {
+			path := t.TempDir()
+			store := s.open(t, path)
+
+			// the task index 256 is before 2 if the key not in big endian
+			entries := []*OutboxEntry{
+				newTestOutboxEntry(256, [32]byte{1}),
+				newTestOutboxEntry(2, [32]byte{2}),
+				newTestOutboxEntry(1, [32]byte{3}),
+				// the same alert signed for another task
+				newTestOutboxEntry(3, [32]byte{2}),
+			}
+			for _, entry := range entries {
+				if err := store.PutEntry(entry); err != nil {
+					t.Fatalf("put the entry %d failed: %v", entry.TaskIndex, err)
+				}
+			}
+
+			// replace the entry by the same task index and alert hash
+			updated := newTestOutboxEntry(2, [32]byte{2})
+			updated.Attempts = 2
+			updated.LastError = "aggregator unavailable"
+			if err := store.PutEntry(updated); err != nil {
+				t.Fatalf("update the entry failed: %v", err)
+			}
+
+			if err := store.DeleteEntry(3, [32]byte{2}); err != nil {
+				t.Fatalf("delete the entry failed: %v", err)
+			}
+
+			expected := []*OutboxEntry{entries[2], updated, entries[0]}
+			assertOutboxEntries(t, store, expected)
+
+			if !s.persistent {
+				return
+			}
+
+			if err := store.Close(); err != nil {
+				t.Fatalf("close the store failed: %v", err)
+			}
+
+			reopened := s.open(t, path)
+			defer reopened.Close()
+
+			assertOutboxEntries(t, reopened, expected)
+		})
+	}
+}
+
+func assertOutboxEntries(t *testing.T, store OutboxStore, expected []*OutboxEntry) {
+	t.Helper()
+
+	entries, err := store.ListEntries()
+	if err != nil {
+		t.Fatalf("list the entries failed: %v", err)
+	}
+
+	if len(entries) != len(expected) {
+		t.Fatalf("expect %d entries, got %d", len(expected), len(entries))
+	}
+
+	for i, entry := range entries {
+		if !reflect.DeepEqual(entry, expected[i]) {
+			t.Fatalf("the entry %d mismatch, expect %+v, got %+v", i, expected[i], entry)
+		}
+	}
+}
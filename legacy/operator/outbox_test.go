@@ -0,0 +1,162 @@
+package operator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sdklogging "github.com/Layr-Labs/eigensdk-go/logging"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alt-research/avs/legacy/core/alert"
+	"github.com/alt-research/avs/legacy/core/message"
+	"github.com/alt-research/avs/legacy/operator/mocks"
+)
+
+func TestOutboxDeliver(t *testing.T) {
+	errUnavailable := errors.New("aggregator unavailable")
+	accepted := alert.AlertResponse{TaskIndex: 1, TxHash: [32]byte{9}, BlockNumber: 150}
+
+	cases := []struct {
+		name string
+		head uint64
+		// the responses of the aggregator for each attempt
+		responses []alert.AlertResponse
+		code      uint32
+		attempts  int
+	}{
+		{
+			name:      "accepted at first",
+			head:      150,
+			responses: []alert.AlertResponse{accepted},
+			attempts:  0,
+		},
+		{
+			name:      "accepted after retry",
+			head:      150,
+			responses: []alert.AlertResponse{{Err: errUnavailable}, {Err: errUnavailable}, accepted},
+			attempts:  2,
+		},
+		{
+			name:      "rejected without retry",
+			head:      150,
+			responses: []alert.AlertResponse{{Err: errors.New("task expired"), Code: 1004}},
+			code:      1004,
+			attempts:  0,
+		},
+		{
+			name:      "expired at the deadline block",
+			head:      200,
+			responses: []alert.AlertResponse{{Err: errUnavailable}},
+			code:      codeOutboxExpired,
+			attempts:  1,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			client := mocks.NewMockAggregatorRpcClienter(ctrl)
+			calls := 0
+			client.EXPECT().
+				SendSignedTaskResponseToAggregator(gomock.Any(), gomock.Any(), gomock.Any()).
+				Do(func(ctx context.Context, signed *message.SignedTaskRespRequest, resChan chan alert.AlertResponse) {
+					// each attempt is sent in a new goroutine after the previous response received,
+					// so the counter must be updated before sending the response
+					res := c.responses[calls]
+					calls += 1
+					resChan <- res
+				}).
+				Times(len(c.responses))
+
+			store := NewMemoryOutboxStore()
+			entry := newTestOutboxEntry(1, [32]byte{1})
+			if err := store.PutEntry(entry); err != nil {
+				t.Fatalf("put the entry failed: %v", err)
+			}
+
+			var finished []alert.AlertResponse
+			o := newOutbox(sdklogging.NewNoopLogger(), store, client, &fakeEthClient{head: c.head}, 100, func(alertHash [32]byte, res alert.AlertResponse) {
+				finished = append(finished, res)
+			}, nil)
+			o.retryMinInterval = time.Millisecond
+			o.retryMaxInterval = time.Millisecond
+
+			job := &outboxJob{
+				entry:   entry,
+				signed:  entry.signedTaskResponse(),
+				resChan: make(chan alert.AlertResponse, 1),
+			}
+			o.deliver(context.Background(), job)
+
+			res := <-job.resChan
+			if res.Code != c.code {
+				t.Fatalf("expect the code %d, got %d: %v", c.code, res.Code, res.Err)
+			}
+			if c.code == 0 && res != accepted {
+				t.Fatalf("expect the response %+v, got %+v", accepted, res)
+			}
+
+			if len(finished) != 1 || finished[0] != res {
+				t.Fatalf("the final response should be handled once, got %+v", finished)
+			}
+
+			if entry.Attempts != c.attempts {
+				t.Fatalf("expect %d failed attempts, got %d", c.attempts, entry.Attempts)
+			}
+
+			assertOutboxEntries(t, store, nil)
+			if len(o.failing) != 0 {
+				t.Fatalf("the entry should not be failing after finished")
+			}
+		})
+	}
+}
+
+func TestOutboxDeliverKeptIfStopped(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	client := mocks.NewMockAggregatorRpcClienter(ctrl)
+	client.EXPECT().
+		SendSignedTaskResponseToAggregator(gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(ctx context.Context, signed *message.SignedTaskRespRequest, resChan chan alert.AlertResponse) {
+			// the operator stopped while the aggregator unreachable
+			cancel()
+			resChan <- alert.AlertResponse{Err: errors.New("aggregator unavailable")}
+		}).
+		MaxTimes(1)
+
+	store := NewMemoryOutboxStore()
+	entry := newTestOutboxEntry(1, [32]byte{1})
+	if err := store.PutEntry(entry); err != nil {
+		t.Fatalf("put the entry failed: %v", err)
+	}
+
+	o := newOutbox(sdklogging.NewNoopLogger(), store, client, &fakeEthClient{head: 150}, 100, nil, nil)
+	o.retryMinInterval = time.Hour
+	o.retryMaxInterval = time.Hour
+
+	job := &outboxJob{
+		entry:   entry,
+		signed:  entry.signedTaskResponse(),
+		resChan: make(chan alert.AlertResponse, 1),
+	}
+	o.deliver(ctx, job)
+
+	select {
+	case res := <-job.resChan:
+		t.Fatalf("the response should not be finished, got %+v", res)
+	default:
+	}
+
+	entries, err := store.ListEntries()
+	if err != nil {
+		t.Fatalf("list the entries failed: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("the entry should be kept to resend after restart, got %d entries", len(entries))
+	}
+}
@@ -0,0 +1,174 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	sdklogging "github.com/Layr-Labs/eigensdk-go/logging"
+
+	l2outputoracle "github.com/alt-research/avs/contracts/bindings/IMachOptimismL2OutputOracle"
+	"github.com/alt-research/avs/legacy/core/alert"
+)
+
+// AlertValidator re-checks the alert from the verifier before the operator signs it,
+// returns a `*TaskVerifyError` with `codeAlertRejected` if the claim of the alert is wrong.
+type AlertValidator interface {
+	ValidateAlert(ctx context.Context, alert alert.Alert) error
+}
+
+// L2OutputRootReader reads the output root of the layer2 block derived by the layer2 node.
+type L2OutputRootReader interface {
+	OutputRootAtBlock(ctx context.Context, l2BlockNumber uint64) (common.Hash, error)
+}
+
+// L2OutputOracleReader reads the output proposals in the layer1 L2OutputOracle.
+type L2OutputOracleReader interface {
+	GetL2Output(ctx context.Context, l2OutputIndex *big.Int) (l2outputoracle.IMachOptimismL2OutputOracleOutputProposal, error)
+}
+
+// opNodeOutputRootReader reads the output root by the `optimism_outputAtBlock` of the op-node.
+type opNodeOutputRootReader struct {
+	client *gethrpc.Client
+}
+
+func NewOpNodeOutputRootReader(ctx context.Context, url string) (L2OutputRootReader, error) {
+	client, err := gethrpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("dial the op-node %s failed: %w", url, err)
+	}
+
+	return &opNodeOutputRootReader{client: client}, nil
+}
+
+func (r *opNodeOutputRootReader) OutputRootAtBlock(ctx context.Context, l2BlockNumber uint64) (common.Hash, error) {
+	var output struct {
+		OutputRoot common.Hash `json:"outputRoot"`
+	}
+	if err := r.client.CallContext(ctx, &output, "optimism_outputAtBlock", hexutil.Uint64(l2BlockNumber)); err != nil {
+		return common.Hash{}, fmt.Errorf("optimism_outputAtBlock %d failed: %w", l2BlockNumber, err)
+	}
+
+	return output.OutputRoot, nil
+}
+
+// l2OutputOracleReader reads the output proposals by the `IMachOptimismL2OutputOracle` binding.
+type l2OutputOracleReader struct {
+	oracle *l2outputoracle.ContractIMachOptimismL2OutputOracleCaller
+}
+
+func NewL2OutputOracleReader(address common.Address, caller bind.ContractCaller) (L2OutputOracleReader, error) {
+	oracle, err := l2outputoracle.NewContractIMachOptimismL2OutputOracleCaller(address, caller)
+	if err != nil {
+		return nil, err
+	}
+
+	return &l2OutputOracleReader{oracle: oracle}, nil
+}
+
+func (r *l2OutputOracleReader) GetL2Output(ctx context.Context, l2OutputIndex *big.Int) (l2outputoracle.IMachOptimismL2OutputOracleOutputProposal, error) {
+	return r.oracle.GetL2Output(&bind.CallOpts{Context: ctx}, l2OutputIndex)
+}
+
+// OpOutputValidator validates the output root alerts of the op stack rollup by the output roots
+// re-derived by a trusted op-node, the alert is rejected if the output root it claims invalid is the same
+// as the op-node derived.
+type OpOutputValidator struct {
+	logger    sdklogging.Logger
+	l2Outputs L2OutputRootReader
+	// nil if the L2OutputOracle not configured, then the `AlertBlockOutputOracleMismatch` will not be validated
+	oracle L2OutputOracleReader
+}
+
+var _ AlertValidator = (*OpOutputValidator)(nil)
+
+func NewOpOutputValidator(logger sdklogging.Logger, l2Outputs L2OutputRootReader, oracle L2OutputOracleReader) *OpOutputValidator {
+	return &OpOutputValidator{
+		logger:    logger,
+		l2Outputs: l2Outputs,
+		oracle:    oracle,
+	}
+}
+
+func (v *OpOutputValidator) ValidateAlert(ctx context.Context, a alert.Alert) error {
+	switch a := a.(type) {
+	case *alert.AlertBlockMismatch:
+		return v.validateBlockMismatch(ctx, a)
+	case alert.AlertBlockMismatch:
+		return v.validateBlockMismatch(ctx, &a)
+	case *alert.AlertBlockOutputOracleMismatch:
+		return v.validateOutputOracleMismatch(ctx, a)
+	case alert.AlertBlockOutputOracleMismatch:
+		return v.validateOutputOracleMismatch(ctx, &a)
+	default:
+		// the other alerts not about the output root
+		return nil
+	}
+}
+
+func (v *OpOutputValidator) validateBlockMismatch(ctx context.Context, a *alert.AlertBlockMismatch) error {
+	if a.InvalidOutputRoot == a.ExpectOutputRoot {
+		return newTaskVerifyError(codeAlertRejected, "the invalid output root is the same as the expected 0x%x", a.ExpectOutputRoot[:])
+	}
+
+	// the l2 block number is decoded from uint64
+	l2BlockNumber := a.L2BlockNumber.BigInt()
+
+	outputRoot, err := v.l2Outputs.OutputRootAtBlock(ctx, l2BlockNumber.Uint64())
+	if err != nil {
+		return newTaskVerifyError(codeAlertValidateFailed, "get the output root of l2 block %s failed: %v", l2BlockNumber, err)
+	}
+
+	if outputRoot == common.Hash(a.InvalidOutputRoot) {
+		return newTaskVerifyError(
+			codeAlertRejected, "the invalid output root %s is the same as the op-node derived at l2 block %s", outputRoot, l2BlockNumber,
+		)
+	}
+
+	if outputRoot != common.Hash(a.ExpectOutputRoot) {
+		v.logger.Warn("The expected output root of the alert not match the op-node derived",
+			"l2BlockNumber", l2BlockNumber, "expect", common.Hash(a.ExpectOutputRoot), "derived", outputRoot)
+	}
+
+	return nil
+}
+
+func (v *OpOutputValidator) validateOutputOracleMismatch(ctx context.Context, a *alert.AlertBlockOutputOracleMismatch) error {
+	if v.oracle == nil {
+		v.logger.Warn("No L2OutputOracle configured, skip validating the alert", "invalidOutputIndex", a.InvalidOutputIndex.BigInt())
+		return nil
+	}
+
+	index := a.InvalidOutputIndex.BigInt()
+	proposal, err := v.oracle.GetL2Output(ctx, index)
+	if err != nil {
+		return newTaskVerifyError(codeAlertValidateFailed, "get the output proposal %s from L2OutputOracle failed: %v", index, err)
+	}
+
+	if proposal.OutputRoot == a.ExpectOutputRoot {
+		return newTaskVerifyError(codeAlertRejected, "the proposed output root %s is the same as the expected", common.Hash(proposal.OutputRoot))
+	}
+
+	if !proposal.L2BlockNumber.IsUint64() {
+		return newTaskVerifyError(codeAlertRejected, "the l2 block number %s of the output proposal %s overflow", proposal.L2BlockNumber, index)
+	}
+
+	outputRoot, err := v.l2Outputs.OutputRootAtBlock(ctx, proposal.L2BlockNumber.Uint64())
+	if err != nil {
+		return newTaskVerifyError(codeAlertValidateFailed, "get the output root of l2 block %s failed: %v", proposal.L2BlockNumber, err)
+	}
+
+	if outputRoot == common.Hash(proposal.OutputRoot) {
+		return newTaskVerifyError(
+			codeAlertRejected,
+			"the proposed output root %s of index %s is the same as the op-node derived at l2 block %s", outputRoot, index, proposal.L2BlockNumber,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package operator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	sdklogging "github.com/Layr-Labs/eigensdk-go/logging"
+	"github.com/ethereum/go-ethereum/common"
+
+	l2outputoracle "github.com/alt-research/avs/contracts/bindings/IMachOptimismL2OutputOracle"
+	"github.com/alt-research/avs/legacy/core/alert"
+)
+
+// stubL2OutputRoots returns the output roots by the l2 block number, the block not in it is failed.
+type stubL2OutputRoots map[uint64]common.Hash
+
+func (s stubL2OutputRoots) OutputRootAtBlock(ctx context.Context, l2BlockNumber uint64) (common.Hash, error) {
+	root, ok := s[l2BlockNumber]
+	if !ok {
+		return common.Hash{}, fmt.Errorf("no output root at l2 block %d", l2BlockNumber)
+	}
+
+	return root, nil
+}
+
+// stubL2OutputOracle returns the output proposals by the index, the index not in it is failed.
+type stubL2OutputOracle map[uint64]l2outputoracle.IMachOptimismL2OutputOracleOutputProposal
+
+func (s stubL2OutputOracle) GetL2Output(ctx context.Context, l2OutputIndex *big.Int) (l2outputoracle.IMachOptimismL2OutputOracleOutputProposal, error) {
+	proposal, ok := s[l2OutputIndex.Uint64()]
+	if !ok {
+		return l2outputoracle.IMachOptimismL2OutputOracleOutputProposal{}, fmt.Errorf("no output proposal %s", l2OutputIndex)
+	}
+
+	return proposal, nil
+}
+
+// decodeAlert decodes the alert from the json as the verifier sent.
+func decodeAlert[T alert.Alert](t *testing.T, raw string) T {
+	t.Helper()
+
+	var a T
+	if err := json.Unmarshal([]byte(raw), &a); err != nil {
+		t.Fatalf("decode alert %s failed: %v", raw, err)
+	}
+
+	return a
+}
+
+func TestOpOutputValidator(t *testing.T) {
+	var (
+		derivedRoot = common.HexToHash("0x01")
+		invalidRoot = common.HexToHash("0x02")
+		otherRoot   = common.HexToHash("0x03")
+	)
+
+	l2Outputs := stubL2OutputRoots{100: derivedRoot, 200: derivedRoot}
+	oracle := stubL2OutputOracle{
+		// the proposal with a wrong output root at l2 block 100
+		1: {OutputRoot: invalidRoot, L2BlockNumber: big.NewInt(100)},
+		// the proposal same as the op-node derived
+		2: {OutputRoot: derivedRoot, L2BlockNumber: big.NewInt(200)},
+		// the proposal at the l2 block the op-node can not derive
+		3: {OutputRoot: invalidRoot, L2BlockNumber: big.NewInt(300)},
+	}
+
+	blockMismatch := func(invalid, expect common.Hash, l2BlockNumber uint64) string {
+		return fmt.Sprintf(
+			`{"avs_name":"mach","invalid_output_root":"%x","expect_output_root":"%x","l2_block_number":%d}`, invalid, expect, l2BlockNumber,
+		)
+	}
+	oracleMismatch := func(expect common.Hash, index uint64) string {
+		return fmt.Sprintf(`{"avs_name":"mach","expect_output_root":"%x","invalid_output_index":%d}`, expect, index)
+	}
+
+	cases := []struct {
+		name   string
+		alert  alert.Alert
+		oracle L2OutputOracleReader
+		// 0 means the alert should be accepted
+		code uint32
+	}{
+		{
+			name:  "block mismatch accepted",
+			alert: decodeAlert[*alert.AlertBlockMismatch](t, blockMismatch(invalidRoot, derivedRoot, 100)),
+		},
+		{
+			name:  "block mismatch accepted by value",
+			alert: *decodeAlert[*alert.AlertBlockMismatch](t, blockMismatch(invalidRoot, derivedRoot, 100)),
+		},
+		{
+			name:  "block mismatch accepted with the expected root not match the derived",
+			alert: decodeAlert[*alert.AlertBlockMismatch](t, blockMismatch(invalidRoot, otherRoot, 100)),
+		},
+		{
+			name:  "block mismatch with the invalid root same as the expected",
+			alert: decodeAlert[*alert.AlertBlockMismatch](t, blockMismatch(invalidRoot, invalidRoot, 100)),
+			code:  codeAlertRejected,
+		},
+		{
+			name:  "block mismatch with the invalid root same as the derived",
+			alert: decodeAlert[*alert.AlertBlockMismatch](t, blockMismatch(derivedRoot, otherRoot, 100)),
+			code:  codeAlertRejected,
+		},
+		{
+			name:  "block mismatch with the output root not derived",
+			alert: decodeAlert[*alert.AlertBlockMismatch](t, blockMismatch(invalidRoot, derivedRoot, 300)),
+			code:  codeAlertValidateFailed,
+		},
+		{
+			name:   "oracle mismatch accepted",
+			alert:  decodeAlert[*alert.AlertBlockOutputOracleMismatch](t, oracleMismatch(derivedRoot, 1)),
+			oracle: oracle,
+		},
+		{
+			name:   "oracle mismatch accepted by value",
+			alert:  *decodeAlert[*alert.AlertBlockOutputOracleMismatch](t, oracleMismatch(derivedRoot, 1)),
+			oracle: oracle,
+		},
+		{
+			name:  "oracle mismatch not validated without oracle",
+			alert: decodeAlert[*alert.AlertBlockOutputOracleMismatch](t, oracleMismatch(derivedRoot, 2)),
+		},
+		{
+			name:   "oracle mismatch with the proposed root same as the expected",
+			alert:  decodeAlert[*alert.AlertBlockOutputOracleMismatch](t, oracleMismatch(invalidRoot, 1)),
+			oracle: oracle,
+			code:   codeAlertRejected,
+		},
+		{
+			name:   "oracle mismatch with the proposed root same as the derived",
+			alert:  decodeAlert[*alert.AlertBlockOutputOracleMismatch](t, oracleMismatch(otherRoot, 2)),
+			oracle: oracle,
+			code:   codeAlertRejected,
+		},
+		{
+			name:   "oracle mismatch with the proposal not found",
+			alert:  decodeAlert[*alert.AlertBlockOutputOracleMismatch](t, oracleMismatch(derivedRoot, 4)),
+			oracle: oracle,
+			code:   codeAlertValidateFailed,
+		},
+		{
+			name:   "oracle mismatch with the output root not derived",
+			alert:  decodeAlert[*alert.AlertBlockOutputOracleMismatch](t, oracleMismatch(derivedRoot, 3)),
+			oracle: oracle,
+			code:   codeAlertValidateFailed,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			validator := NewOpOutputValidator(sdklogging.NewNoopLogger(), l2Outputs, c.oracle)
+
+			err := validator.ValidateAlert(context.Background(), c.alert)
+			if c.code == 0 {
+				if err != nil {
+					t.Fatalf("the alert should be accepted, got %v", err)
+				}
+				return
+			}
+
+			var verifyErr *TaskVerifyError
+			if !errors.As(err, &verifyErr) {
+				t.Fatalf("expect the task verify error %d, got %v", c.code, err)
+			}
+
+			if verifyErr.Code != c.code {
+				t.Fatalf("expect the code %d, got %d: %s", c.code, verifyErr.Code, verifyErr.Message)
+			}
+		})
+	}
+}
@@ -1,25 +1,28 @@
 package operator
 
 import (
+	"context"
 	"fmt"
 	"net/rpc"
 	"strings"
 	"time"
 
+	"github.com/alt-research/avs/legacy/aggregator/types"
 	"github.com/alt-research/avs/legacy/core/alert"
 	"github.com/alt-research/avs/legacy/core/config"
 	"github.com/alt-research/avs/legacy/core/message"
 	"github.com/alt-research/avs/legacy/metrics"
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/Layr-Labs/eigensdk-go/crypto/bls"
 	"github.com/Layr-Labs/eigensdk-go/logging"
 	sdktypes "github.com/Layr-Labs/eigensdk-go/types"
 )
 
 type AggregatorRpcClienter interface {
-	InitOperatorToAggregator() error
-	CreateAlertTaskToAggregator(alertHash [32]byte) (*message.AlertTaskInfo, error)
-	SendSignedTaskResponseToAggregator(signedTaskResponse *message.SignedTaskRespRequest, resChan chan alert.AlertResponse)
+	InitOperatorToAggregator(ctx context.Context) error
+	CreateAlertTaskToAggregator(ctx context.Context, alertHash [32]byte) (*message.AlertTaskInfo, error)
+	SendSignedTaskResponseToAggregator(ctx context.Context, signedTaskResponse *message.SignedTaskRespRequest, resChan chan alert.AlertResponse)
 }
 type AggregatorRpcClient struct {
 	rpcClient                  *rpc.Client
@@ -27,13 +30,14 @@ type AggregatorRpcClient struct {
 	logger                     logging.Logger
 	config                     config.NodeConfig
 	operatorId                 sdktypes.OperatorId
+	blsKeypair                 *bls.KeyPair
 	operatorAddr               common.Address
 	OperatorStateRetrieverAddr common.Address
 	RegistryCoordinatorAddr    common.Address
 	aggregatorIpPortAddr       string
 }
 
-func NewAggregatorRpcClient(config config.NodeConfig, operatorId sdktypes.OperatorId, operatorAddr common.Address, logger logging.Logger, metrics metrics.Metrics) (*AggregatorRpcClient, error) {
+func NewAggregatorRpcClient(config config.NodeConfig, operatorId sdktypes.OperatorId, blsKeypair *bls.KeyPair, operatorAddr common.Address, logger logging.Logger, metrics metrics.Metrics) (*AggregatorRpcClient, error) {
 	return &AggregatorRpcClient{
 		// set to nil so that we can create an rpc client even if the aggregator is not running
 		rpcClient:                  nil,
@@ -41,6 +45,7 @@ func NewAggregatorRpcClient(config config.NodeConfig, operatorId sdktypes.Operat
 		logger:                     logger,
 		config:                     config,
 		operatorId:                 operatorId,
+		blsKeypair:                 blsKeypair,
 		operatorAddr:               operatorAddr,
 		OperatorStateRetrieverAddr: common.HexToAddress(config.OperatorStateRetrieverAddress),
 		RegistryCoordinatorAddr:    common.HexToAddress(config.AVSRegistryCoordinatorAddress),
@@ -59,7 +64,7 @@ func (c *AggregatorRpcClient) dialAggregatorRpcClient() (err error) {
 }
 
 // CreateAlertTaskToAggregator create a new alert task, if had existing, just return current alert task.
-func (c *AggregatorRpcClient) InitOperatorToAggregator() error {
+func (c *AggregatorRpcClient) InitOperatorToAggregator(ctx context.Context) error {
 	if c.rpcClient == nil {
 		c.logger.Info("rpc client is nil. Dialing aggregator rpc client")
 		err := c.dialAggregatorRpcClient()
@@ -82,7 +87,8 @@ func (c *AggregatorRpcClient) InitOperatorToAggregator() error {
 	c.logger.Info("Init operator to aggregator", "req", fmt.Sprintf("%#v", req))
 
 	for i := 0; i < 5; i++ {
-		err := c.rpcClient.Call("Aggregator.InitOperator", req, &reply)
+		req.Auth = message.NewOperatorAuth(c.blsKeypair, c.operatorId, message.AuthMethodInitOperator, req.AuthPayload())
+		err := c.call(ctx, "Aggregator.InitOperator", req, &reply)
 		if err != nil {
 			c.logger.Info("Received error from aggregator", "err", err)
 			if strings.Contains(err.Error(), "connection is shut down") {
@@ -108,7 +114,9 @@ func (c *AggregatorRpcClient) InitOperatorToAggregator() error {
 			return nil
 		}
 		c.logger.Infof("Retrying in 2 seconds")
-		time.Sleep(2 * time.Second)
+		if err := sleepContext(ctx, 2*time.Second); err != nil {
+			return err
+		}
 	}
 	c.logger.Errorf("Could not send init operator to aggregator. Tried 5 times.")
 
@@ -116,7 +124,7 @@ func (c *AggregatorRpcClient) InitOperatorToAggregator() error {
 }
 
 // CreateAlertTaskToAggregator create a new alert task, if had existing, just return current alert task.
-func (c *AggregatorRpcClient) CreateAlertTaskToAggregator(alertHash [32]byte) (*message.AlertTaskInfo, error) {
+func (c *AggregatorRpcClient) CreateAlertTaskToAggregator(ctx context.Context, alertHash [32]byte) (*message.AlertTaskInfo, error) {
 	if c.rpcClient == nil {
 		c.logger.Info("rpc client is nil. Dialing aggregator rpc client")
 		err := c.dialAggregatorRpcClient()
@@ -128,16 +136,18 @@ func (c *AggregatorRpcClient) CreateAlertTaskToAggregator(alertHash [32]byte) (*
 	// we don't check this bool. It's just needed because rpc.Call requires rpc methods to have a return value
 	var reply message.CreateTaskResponse
 	req := message.CreateTaskRequest{
-		AlertHash: alertHash,
+		AlertHash:     alertHash,
+		RollupChainId: c.config.Layer2ChainId,
 	}
 
 	c.logger.Info("Create task to aggregator", "req", fmt.Sprintf("%#v", req))
 
 	for i := 0; i < 5; i++ {
-		err := c.rpcClient.Call("Aggregator.CreateTask", req, &reply)
+		req.Auth = message.NewOperatorAuth(c.blsKeypair, c.operatorId, message.AuthMethodCreateTask, req.AuthPayload())
+		err := c.call(ctx, "Aggregator.CreateTask", req, &reply)
 		if err != nil {
 			c.logger.Info("Received error from aggregator", "err", err)
-			if strings.Contains(err.Error(), "already finished") {
+			if strings.Contains(err.Error(), "already finished") || aggregatorErrorCode(err) != 0 {
 				return nil, err
 			}
 			if strings.Contains(err.Error(), "connection is shut down") {
@@ -154,7 +164,9 @@ func (c *AggregatorRpcClient) CreateAlertTaskToAggregator(alertHash [32]byte) (*
 			return &reply.Info, nil
 		}
 		c.logger.Infof("Retrying in 2 seconds")
-		time.Sleep(2 * time.Second)
+		if err := sleepContext(ctx, 2*time.Second); err != nil {
+			return nil, err
+		}
 	}
 	c.logger.Errorf("Could not create task to aggregator. Tried 5 times.")
 
@@ -166,7 +178,7 @@ func (c *AggregatorRpcClient) CreateAlertTaskToAggregator(alertHash [32]byte) (*
 // this is because sending the signed task response to the aggregator is time sensitive,
 // so there is no point in retrying if it fails for a few times.
 // Currently hardcoded to retry sending the signed task response 5 times, waiting 2 seconds in between each attempt.
-func (c *AggregatorRpcClient) SendSignedTaskResponseToAggregator(signedTaskResponse *message.SignedTaskRespRequest, resChan chan alert.AlertResponse) {
+func (c *AggregatorRpcClient) SendSignedTaskResponseToAggregator(ctx context.Context, signedTaskResponse *message.SignedTaskRespRequest, resChan chan alert.AlertResponse) {
 	if c.rpcClient == nil {
 		c.logger.Info("rpc client is nil. Dialing aggregator rpc client")
 		err := c.dialAggregatorRpcClient()
@@ -186,11 +198,28 @@ func (c *AggregatorRpcClient) SendSignedTaskResponseToAggregator(signedTaskRespo
 	// before the operator gets the new task created log from anvil (because blocks are mined instantly)
 	// the aggregator needs to read some onchain data related to quorums before it can accept operator signed task responses.
 	c.logger.Info("Sending signed task response header to aggregator", "signedTaskResponse", fmt.Sprintf("%#v", signedTaskResponse))
-	var err error
+	authPayload, err := signedTaskResponse.AuthPayload()
+	if err != nil {
+		resChan <- alert.AlertResponse{
+			Err: err,
+			Msg: "Could not hash the signed task response",
+		}
+		return
+	}
 	for i := 0; i < 5; i++ {
-		err = c.rpcClient.Call("Aggregator.ProcessSignedTaskResponse", signedTaskResponse, &response)
+		signedTaskResponse.Auth = message.NewOperatorAuth(c.blsKeypair, c.operatorId, message.AuthMethodProcessSignedTaskResponse, authPayload)
+		err = c.call(ctx, "Aggregator.ProcessSignedTaskResponse", signedTaskResponse, &response)
 		if err != nil {
 			c.logger.Info("Received error from aggregator", "err", err)
+			// the signature rejected by aggregator, no need to retry
+			if code := aggregatorErrorCode(err); code != 0 {
+				resChan <- alert.AlertResponse{
+					Code: code,
+					Err:  err,
+					Msg:  "The signed task response rejected by aggregator",
+				}
+				return
+			}
 			if strings.Contains(err.Error(), "connection is shut down") {
 				c.logger.Info("rpc client is down. Dialing aggregator rpc client")
 				err := c.dialAggregatorRpcClient()
@@ -202,16 +231,26 @@ func (c *AggregatorRpcClient) SendSignedTaskResponseToAggregator(signedTaskRespo
 			c.logger.Info("Signed task response header accepted by aggregator.", "response", response)
 			c.metrics.IncNumTasksAcceptedByAggregator()
 
-			resChan <- alert.AlertResponse{
+			res := alert.AlertResponse{
 				Code:      0,
 				TxHash:    response.TxHash,
 				TaskIndex: signedTaskResponse.Alert.TaskIndex,
 			}
 
+			waitTaskSubmitted(ctx, c.logger, c.config.WaitTaskSubmittedTimeout, c.getTaskStatus, &res)
+
+			resChan <- res
+
 			return
 		}
 		c.logger.Infof("Retrying in 2 seconds")
-		time.Sleep(2 * time.Second)
+		if err := sleepContext(ctx, 2*time.Second); err != nil {
+			resChan <- alert.AlertResponse{
+				Err: err,
+				Msg: "Stop sending signed task response to aggregator",
+			}
+			return
+		}
 	}
 	c.logger.Errorf("Could not send signed task response to aggregator. Tried 5 times.")
 
@@ -219,3 +258,52 @@ func (c *AggregatorRpcClient) SendSignedTaskResponseToAggregator(signedTaskRespo
 		Err: fmt.Errorf("could not send signed task response to aggregator by %v", err),
 	}
 }
+
+func (c *AggregatorRpcClient) getTaskStatus(ctx context.Context, taskIndex types.TaskIndex) (*message.TaskStatus, error) {
+	if c.rpcClient == nil {
+		if err := c.dialAggregatorRpcClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	var reply message.TaskStatus
+	if err := c.call(ctx, "Aggregator.GetTaskStatus", message.GetTaskStatusRequest{TaskIndex: taskIndex}, &reply); err != nil {
+		return nil, err
+	}
+
+	return &reply, nil
+}
+
+// call calls the method of aggregator, returns the ctx error if the ctx done before the reply.
+func (c *AggregatorRpcClient) call(ctx context.Context, serviceMethod string, args any, reply any) error {
+	call := c.rpcClient.Go(serviceMethod, args, reply, make(chan *rpc.Call, 1))
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-call.Done:
+		return call.Error
+	}
+}
+
+// sleepContext sleeps the duration, returns the ctx error if the ctx done before.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// aggregatorErrorCode returns the code of the error returned by aggregator, 0 if the error has no code.
+func aggregatorErrorCode(err error) uint32 {
+	if aggErr, ok := message.ParseAggregatorError(err); ok {
+		return uint32(aggErr.Code)
+	}
+
+	return 0
+}
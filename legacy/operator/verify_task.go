@@ -0,0 +1,122 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alt-research/avs/legacy/core/message"
+)
+
+// The error codes for the alert or the task refused to sign by the operator, the codes are in [2000, 3000).
+const (
+	// the alert hash of the task not match the alert sent by the verifier
+	codeTaskAlertHashMismatch uint32 = 2001
+	// the rollup chain id of the task not match the `layer2_chain_id`
+	codeTaskRollupMismatch uint32 = 2002
+	// the reference block not exist or not confirmed by `reference_block_confirmations` blocks
+	codeTaskReferenceBlockNotConfirmed uint32 = 2003
+	// the reference block is older than `alert_expired_blocks`, the task would have expired
+	codeTaskReferenceBlockTooOld uint32 = 2004
+	// the quorums of the task are empty, duplicated or not exist at the reference block
+	codeTaskQuorumsMismatch uint32 = 2005
+	// the threshold percentages of the task not match the service manager at the reference block
+	codeTaskThresholdsMismatch uint32 = 2006
+	// the alert is rejected by the validator, such as the output root it claims invalid is the same as the chain
+	codeAlertRejected uint32 = 2007
+	// the validator can not get the data from the chain to validate the alert
+	codeAlertValidateFailed uint32 = 2008
+)
+
+// TaskVerifyError is the error for the task from aggregator which the operator refused to sign.
+type TaskVerifyError struct {
+	Code    uint32
+	Message string
+}
+
+func newTaskVerifyError(code uint32, format string, args ...interface{}) *TaskVerifyError {
+	return &TaskVerifyError{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func (e *TaskVerifyError) Error() string {
+	return fmt.Sprintf("refuse to sign the task by %d: %s", e.Code, e.Message)
+}
+
+// verifyTaskInfo checks the task returned by aggregator before signing it, the task should be for the alert
+// of the verifier and the rollup of the operator, the reference block should be confirmed and not expired,
+// and the quorums with the thresholds should match the service manager at the reference block.
+func (o *Operator) verifyTaskInfo(ctx context.Context, alertHash [32]byte, task *message.AlertTaskInfo) error {
+	if task.AlertHash != alertHash {
+		return newTaskVerifyError(codeTaskAlertHashMismatch, "the task alert %s not match the alert %x", task.AlertHash, alertHash)
+	}
+
+	if task.RollupChainId != o.config.Layer2ChainId {
+		return newTaskVerifyError(
+			codeTaskRollupMismatch, "the task rollup chain id %d not match the layer2 chain id %d", task.RollupChainId, o.config.Layer2ChainId,
+		)
+	}
+
+	head, err := o.ethClient.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("get the head block failed: %w", err)
+	}
+
+	if task.ReferenceBlockNumber+o.config.ReferenceBlockConfirmations > head {
+		return newTaskVerifyError(
+			codeTaskReferenceBlockNotConfirmed,
+			"the reference block %d not confirmed by %d blocks, head %d", task.ReferenceBlockNumber, o.config.ReferenceBlockConfirmations, head,
+		)
+	}
+
+	if head-task.ReferenceBlockNumber >= o.alertTracker.expiredBlocks {
+		return newTaskVerifyError(
+			codeTaskReferenceBlockTooOld,
+			"the reference block %d is older than %d blocks, head %d", task.ReferenceBlockNumber, o.alertTracker.expiredBlocks, head,
+		)
+	}
+
+	if len(task.QuorumNumbers) == 0 || len(task.QuorumNumbers) != len(task.QuorumThresholdPercentages) {
+		return newTaskVerifyError(
+			codeTaskQuorumsMismatch,
+			"the task has %d quorums with %d thresholds", len(task.QuorumNumbers), len(task.QuorumThresholdPercentages),
+		)
+	}
+
+	quorums, err := o.avsReader.GetQuorumsByBlockNumber(ctx, uint32(task.ReferenceBlockNumber))
+	if err != nil {
+		return fmt.Errorf("get the quorums at block %d failed: %w", task.ReferenceBlockNumber, err)
+	}
+
+	seen := make(map[uint8]struct{}, len(task.QuorumNumbers))
+	for _, quorum := range task.QuorumNumbers {
+		if _, ok := seen[uint8(quorum)]; ok {
+			return newTaskVerifyError(codeTaskQuorumsMismatch, "the task quorum %d is duplicated", quorum)
+		}
+		seen[uint8(quorum)] = struct{}{}
+
+		if int(quorum) >= len(quorums) {
+			return newTaskVerifyError(
+				codeTaskQuorumsMismatch,
+				"the task quorum %d not exist at block %d, the quorum count is %d", quorum, task.ReferenceBlockNumber, len(quorums),
+			)
+		}
+	}
+
+	thresholds, err := o.avsReader.GetQuorumThresholdPercentages(ctx, uint32(task.ReferenceBlockNumber), task.QuorumNumbers)
+	if err != nil {
+		return fmt.Errorf("get the quorum threshold percentages at block %d failed: %w", task.ReferenceBlockNumber, err)
+	}
+
+	for i, threshold := range task.QuorumThresholdPercentages {
+		if threshold != thresholds[i] {
+			return newTaskVerifyError(
+				codeTaskThresholdsMismatch,
+				"the task threshold %d of quorum %d not match %d at block %d", threshold, task.QuorumNumbers[i], thresholds[i], task.ReferenceBlockNumber,
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package operator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Layr-Labs/eigensdk-go/chainio/clients/eth"
+	sdktypes "github.com/Layr-Labs/eigensdk-go/types"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alt-research/avs/legacy/core/chainio/mocks"
+	"github.com/alt-research/avs/legacy/core/config"
+	"github.com/alt-research/avs/legacy/core/message"
+)
+
+// fakeEthClient is the eth client with a fixed head block, the other methods are not implemented.
+type fakeEthClient struct {
+	eth.Client
+	head uint64
+}
+
+func (c *fakeEthClient) BlockNumber(ctx context.Context) (uint64, error) {
+	return c.head, nil
+}
+
+func TestVerifyTaskInfo(t *testing.T) {
+	const (
+		rollupChainId uint32 = 42
+		head          uint64 = 1000
+		expiredBlocks uint64 = 100
+		confirmations uint64 = 3
+	)
+
+	alertHash := [32]byte{1}
+
+	// the valid task, each case changes one field of it
+	newTask := func(modify func(task *message.AlertTaskInfo)) *message.AlertTaskInfo {
+		task := &message.AlertTaskInfo{
+			AlertHash:                  alertHash,
+			QuorumNumbers:              sdktypes.QuorumNums{0, 1},
+			QuorumThresholdPercentages: sdktypes.QuorumThresholdPercentages{66, 50},
+			TaskIndex:                  1,
+			ReferenceBlockNumber:       head - confirmations,
+			RollupChainId:              rollupChainId,
+		}
+		if modify != nil {
+			modify(task)
+		}
+		return task
+	}
+
+	cases := []struct {
+		name string
+		task *message.AlertTaskInfo
+		// 0 means the task should be accepted
+		code uint32
+	}{
+		{
+			name: "valid",
+			task: newTask(nil),
+		},
+		{
+			name: "alert hash mismatch",
+			task: newTask(func(task *message.AlertTaskInfo) { task.AlertHash = [32]byte{2} }),
+			code: codeTaskAlertHashMismatch,
+		},
+		{
+			name: "rollup mismatch",
+			task: newTask(func(task *message.AlertTaskInfo) { task.RollupChainId = rollupChainId + 1 }),
+			code: codeTaskRollupMismatch,
+		},
+		{
+			name: "reference block in the future",
+			task: newTask(func(task *message.AlertTaskInfo) { task.ReferenceBlockNumber = head + 1 }),
+			code: codeTaskReferenceBlockNotConfirmed,
+		},
+		{
+			name: "reference block not confirmed",
+			task: newTask(func(task *message.AlertTaskInfo) { task.ReferenceBlockNumber = head - confirmations + 1 }),
+			code: codeTaskReferenceBlockNotConfirmed,
+		},
+		{
+			name: "reference block the last one not expired",
+			task: newTask(func(task *message.AlertTaskInfo) { task.ReferenceBlockNumber = head - expiredBlocks + 1 }),
+		},
+		{
+			name: "reference block expired at the head",
+			task: newTask(func(task *message.AlertTaskInfo) { task.ReferenceBlockNumber = head - expiredBlocks }),
+			code: codeTaskReferenceBlockTooOld,
+		},
+		{
+			name: "no quorums",
+			task: newTask(func(task *message.AlertTaskInfo) {
+				task.QuorumNumbers = nil
+				task.QuorumThresholdPercentages = nil
+			}),
+			code: codeTaskQuorumsMismatch,
+		},
+		{
+			name: "thresholds count mismatch",
+			task: newTask(func(task *message.AlertTaskInfo) {
+				task.QuorumThresholdPercentages = sdktypes.QuorumThresholdPercentages{66}
+			}),
+			code: codeTaskQuorumsMismatch,
+		},
+		{
+			name: "duplicated quorum",
+			task: newTask(func(task *message.AlertTaskInfo) { task.QuorumNumbers = sdktypes.QuorumNums{1, 1} }),
+			code: codeTaskQuorumsMismatch,
+		},
+		{
+			name: "quorum not exist",
+			task: newTask(func(task *message.AlertTaskInfo) { task.QuorumNumbers = sdktypes.QuorumNums{0, 2} }),
+			code: codeTaskQuorumsMismatch,
+		},
+		{
+			name: "thresholds mismatch",
+			task: newTask(func(task *message.AlertTaskInfo) {
+				task.QuorumThresholdPercentages = sdktypes.QuorumThresholdPercentages{66, 51}
+			}),
+			code: codeTaskThresholdsMismatch,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			avsReader := mocks.NewMockAvsReaderer(ctrl)
+			avsReader.EXPECT().
+				GetQuorumsByBlockNumber(gomock.Any(), uint32(c.task.ReferenceBlockNumber)).
+				Return(sdktypes.QuorumNums{0, 1}, nil).
+				AnyTimes()
+			avsReader.EXPECT().
+				GetQuorumThresholdPercentages(gomock.Any(), uint32(c.task.ReferenceBlockNumber), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, blockNumber uint32, quorums sdktypes.QuorumNums) (sdktypes.QuorumThresholdPercentages, error) {
+					thresholds := map[sdktypes.QuorumNum]sdktypes.QuorumThresholdPercentage{0: 66, 1: 50}
+
+					res := make(sdktypes.QuorumThresholdPercentages, 0, len(quorums))
+					for _, quorum := range quorums {
+						res = append(res, thresholds[quorum])
+					}
+					return res, nil
+				}).
+				AnyTimes()
+
+			o := &Operator{
+				config: config.NodeConfig{
+					Layer2ChainId:               rollupChainId,
+					ReferenceBlockConfirmations: confirmations,
+				},
+				ethClient:    &fakeEthClient{head: head},
+				avsReader:    avsReader,
+				alertTracker: &alertTracker{expiredBlocks: expiredBlocks},
+			}
+
+			err := o.verifyTaskInfo(context.Background(), alertHash, c.task)
+			if c.code == 0 {
+				if err != nil {
+					t.Fatalf("the task should be accepted, got %v", err)
+				}
+				return
+			}
+
+			var verifyErr *TaskVerifyError
+			if !errors.As(err, &verifyErr) {
+				t.Fatalf("expect the task verify error %d, got %v", c.code, err)
+			}
+
+			if verifyErr.Code != c.code {
+				t.Fatalf("expect the code %d, got %d: %s", c.code, verifyErr.Code, verifyErr.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Layr-Labs/eigensdk-go/logging"
+
+	"github.com/alt-research/avs/legacy/aggregator/types"
+	"github.com/alt-research/avs/legacy/core/alert"
+	"github.com/alt-research/avs/legacy/core/message"
+)
+
+const (
+	// the interval to query the task status from aggregator when waiting the task submitted
+	waitTaskStatusInterval = 2 * time.Second
+)
+
+// the task states in aggregator, which used for waiting the task submitted
+const (
+	taskStateSubmitted = "submitted"
+	taskStateConfirmed = "confirmed"
+	taskStateExpired   = "expired"
+	taskStateFailed    = "failed"
+)
+
+type taskStatusGetter func(ctx context.Context, taskIndex types.TaskIndex) (*message.TaskStatus, error)
+
+// waitTaskSubmitted queries the task status until the confirm alert tx confirmed, or the task terminated,
+// or the timeout reached, then fills the tx hash and block number to the response.
+// If the timeout reached or the ctx done when the tx had submitted but not confirmed, the response only carries the tx hash.
+func waitTaskSubmitted(
+	ctx context.Context,
+	logger logging.Logger,
+	timeout time.Duration,
+	getStatus taskStatusGetter,
+	res *alert.AlertResponse,
+) {
+	if timeout <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(waitTaskStatusInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := getStatus(ctx, res.TaskIndex)
+		if err != nil {
+			logger.Warn("get task status from aggregator failed", "taskIndex", res.TaskIndex, "err", err)
+		} else {
+			switch status.State {
+			case taskStateSubmitted:
+				res.TxHash = status.TxHash
+			case taskStateConfirmed:
+				res.TxHash = status.TxHash
+				res.BlockNumber = status.BlockNumber
+				logger.Info("the task confirmed", "taskIndex", res.TaskIndex, "txHash", fmt.Sprintf("0x%x", res.TxHash))
+				return
+			case taskStateExpired, taskStateFailed:
+				res.TxHash = status.TxHash
+				res.Code = codeTaskNotConfirmed
+				res.Err = fmt.Errorf("task %d %s: %s", res.TaskIndex, status.State, status.Reason)
+				res.Msg = "the task not confirmed by aggregator"
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Warn("wait task submitted timeout", "taskIndex", res.TaskIndex, "txHash", fmt.Sprintf("0x%x", res.TxHash))
+			return
+		case <-ticker.C:
+		}
+	}
+}